@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func commandAbility(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, an ability name. Received none")
+	}
+	ability, err := c.client.GetAbility(ctx, c.args[0])
+	if errors.Is(err, pokeclient.ErrAbilityNotFound) {
+		fmt.Printf("Ability %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Name: %s\n", displayName(c, ability, ability.Name))
+	if description := localizedText(c, ability.Description); description != "" {
+		fmt.Println(description)
+	}
+	names := make([]string, len(ability.Pokemon))
+	for i, entry := range ability.Pokemon {
+		names[i] = entry.Pokemon.Name
+	}
+	names, err = localizePokemonNames(ctx, c, names)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Pokemon:")
+	for i, entry := range ability.Pokemon {
+		fmt.Printf("  - %s%s\n", names[i], hiddenSuffix(entry.IsHidden))
+	}
+	return nil
+}
+
+func hiddenSuffix(isHidden bool) string {
+	if isHidden {
+		return " (hidden)"
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestCommandAbilityNoArgs(t *testing.T) {
+	config := &Config{
+		args: []string{},
+	}
+
+	err := commandAbility(context.Background(), config)
+	if err == nil {
+		t.Error("commandAbility should return error when no arguments provided")
+	}
+}
+
+func TestCommandAbility(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name:           "known ability",
+			args:           []string{"static"},
+			serverResponse: `{"name": "static", "pokemon": [{"is_hidden": false, "slot": 1, "pokemon": {"name": "pikachu"}}]}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			// Should not return error for unknown abilities, just print message
+			name:         "unknown ability - 404",
+			args:         []string{"unknown"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"static"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"static"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandAbility(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCommandInspectWithAbilities(t *testing.T) {
+	config := &Config{
+		args: []string{"pikachu"},
+		pokedex: map[string]pokeclient.Pokemon{
+			"pikachu": {
+				Name: "pikachu",
+				Abilities: []pokeclient.PokemonAbility{
+					{Ability: pokeclient.Entry{Name: "static"}, Slot: 1},
+					{Ability: pokeclient.Entry{Name: "lightning-rod"}, IsHidden: true, Slot: 3},
+				},
+			},
+		},
+	}
+
+	err := commandInspect(context.Background(), config)
+	if err != nil {
+		t.Errorf("commandInspect should not return error, got %v", err)
+	}
+}
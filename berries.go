@@ -0,0 +1,73 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+	"github.com/jabreu610/pokedexcli/internal/repl"
+)
+
+func commandBerry(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, a berry name. Received none")
+	}
+	berry, err := c.client.GetBerry(ctx, c.args[0])
+	if errors.Is(err, pokeclient.ErrBerryNotFound) {
+		fmt.Printf("Berry %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Name: %s\n", berry.Name)
+	fmt.Printf("Growth time: %d hours per stage\n", berry.GrowthTime)
+	fmt.Printf("Max harvest: %d\n", berry.MaxHarvest)
+	fmt.Printf("Size: %d mm\n", berry.Size)
+	fmt.Printf("Smoothness: %d\n", berry.Smoothness)
+	fmt.Printf("Firmness: %s\n", berry.Firmness.Name)
+	fmt.Printf("Natural gift: %s, power %d\n", berry.NaturalGiftType.Name, berry.NaturalGiftPower)
+	fmt.Println("Flavors:")
+	for _, flavor := range berry.Flavors {
+		if flavor.Potency > 0 {
+			fmt.Printf("  - %s: %d\n", flavor.Flavor.Name, flavor.Potency)
+		}
+	}
+	return nil
+}
+
+func commandBerries(ctx context.Context, c *Config) error {
+	name, ok := repl.ParseArgs(c.args).Flag("flavor")
+	if !ok || name == "" {
+		return errors.New("Expected a flavor, usage: berries --flavor <flavor>")
+	}
+	flavor, err := c.client.GetBerryFlavor(ctx, name)
+	if errors.Is(err, pokeclient.ErrBerryFlavorNotFound) {
+		fmt.Printf("Berry flavor %s does not exist\n", name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	printBerriesByPotency(os.Stdout, flavor.Berries)
+	return nil
+}
+
+// printBerriesByPotency lists the berries that have the flavor at all, most
+// potent first.
+func printBerriesByPotency(w io.Writer, berries []pokeclient.FlavorBerry) {
+	berries = slices.DeleteFunc(slices.Clone(berries), func(b pokeclient.FlavorBerry) bool {
+		return b.Potency <= 0
+	})
+	slices.SortFunc(berries, func(a, b pokeclient.FlavorBerry) int {
+		return cmp.Or(cmp.Compare(b.Potency, a.Potency), cmp.Compare(a.Berry.Name, b.Berry.Name))
+	})
+	for _, berry := range berries {
+		fmt.Fprintf(w, "%s: %d\n", berry.Berry.Name, berry.Potency)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestPrintBerriesByPotency(t *testing.T) {
+	var out strings.Builder
+	printBerriesByPotency(&out, []pokeclient.FlavorBerry{
+		{Potency: 10, Berry: pokeclient.Entry{Name: "cheri"}},
+		{Potency: 0, Berry: pokeclient.Entry{Name: "chesto"}},
+		{Potency: 40, Berry: pokeclient.Entry{Name: "tamato"}},
+		{Potency: 10, Berry: pokeclient.Entry{Name: "aguav"}},
+	})
+
+	expected := "tamato: 40\naguav: 10\ncheri: 10\n"
+	if out.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestCommandBerry(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name:           "known berry",
+			args:           []string{"cheri"},
+			serverResponse: `{"name": "cheri", "firmness": {"name": "soft"}, "flavors": [{"potency": 10, "flavor": {"name": "spicy"}}]}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			// Should not return error for unknown berries, just print message
+			name:         "unknown berry - 404",
+			args:         []string{"fake"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"cheri"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"cheri"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:        "no arguments",
+			args:        []string{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandBerry(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCommandBerries(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name:           "known flavor",
+			args:           []string{"--flavor", "spicy"},
+			serverResponse: `{"name": "spicy", "berries": [{"potency": 10, "berry": {"name": "cheri"}}]}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			// Should not return error for unknown flavors, just print message
+			name:         "unknown flavor - 404",
+			args:         []string{"--flavor", "umami"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"--flavor", "spicy"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"--flavor", "spicy"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:        "no flavor",
+			args:        []string{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandBerries(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func commandEvolutions(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, a Pokemon name. Received none")
+	}
+	pokemon, err := lookupPokemon(ctx, c, c.args[0])
+	if errors.Is(err, pokeclient.ErrPokemonNotFound) {
+		fmt.Printf("Pokemon %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	chain, err := c.client.GetEvolutionChain(ctx, pokemon.Species.Name)
+	if errors.Is(err, pokeclient.ErrNoEvolutionChain) {
+		fmt.Printf("%s does not evolve\n", pokemon.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	printEvolutionChain(os.Stdout, chain.Chain)
+	return nil
+}
+
+// printEvolutionChain draws the chain as a tree, with the conditions of each
+// evolution next to the species it evolves into.
+func printEvolutionChain(w io.Writer, link pokeclient.ChainLink) {
+	fmt.Fprintln(w, link.Species.Name)
+	printEvolvesTo(w, link.EvolvesTo, "")
+}
+
+func printEvolvesTo(w io.Writer, links []pokeclient.ChainLink, indent string) {
+	for i, link := range links {
+		branch, childIndent := "├── ", indent+"│   "
+		if i == len(links)-1 {
+			branch, childIndent = "└── ", indent+"    "
+		}
+		fmt.Fprintf(w, "%s%s%s", indent, branch, link.Species.Name)
+		if conditions := evolutionConditions(link.EvolutionDetails); conditions != "" {
+			fmt.Fprintf(w, " (%s)", conditions)
+		}
+		fmt.Fprintln(w)
+		printEvolvesTo(w, link.EvolvesTo, childIndent)
+	}
+}
+
+// evolutionConditions joins the alternative ways of evolving, which differ
+// between games.
+func evolutionConditions(details []pokeclient.EvolutionDetail) string {
+	var ways []string
+	for _, detail := range details {
+		if way := detail.String(); way != "" && !slices.Contains(ways, way) {
+			ways = append(ways, way)
+		}
+	}
+	return strings.Join(ways, " or ")
+}
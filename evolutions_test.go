@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestPrintEvolutionChain(t *testing.T) {
+	level := 16
+	otherLevel := 36
+	chain := pokeclient.ChainLink{
+		Species: pokeclient.Entry{Name: "charmander"},
+		EvolvesTo: []pokeclient.ChainLink{
+			{
+				Species: pokeclient.Entry{Name: "charmeleon"},
+				EvolutionDetails: []pokeclient.EvolutionDetail{
+					{Trigger: pokeclient.Entry{Name: "level-up"}, MinLevel: &level},
+				},
+				EvolvesTo: []pokeclient.ChainLink{
+					{
+						Species: pokeclient.Entry{Name: "charizard"},
+						EvolutionDetails: []pokeclient.EvolutionDetail{
+							{Trigger: pokeclient.Entry{Name: "level-up"}, MinLevel: &otherLevel},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var out strings.Builder
+	printEvolutionChain(&out, chain)
+
+	expected := "charmander\n" +
+		"└── charmeleon (level 16)\n" +
+		"    └── charizard (level 36)\n"
+	if out.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestPrintEvolutionChainBranches(t *testing.T) {
+	stone := func(item string) []pokeclient.EvolutionDetail {
+		return []pokeclient.EvolutionDetail{
+			{Trigger: pokeclient.Entry{Name: "use-item"}, Item: &pokeclient.Entry{Name: item}},
+		}
+	}
+	chain := pokeclient.ChainLink{
+		Species: pokeclient.Entry{Name: "eevee"},
+		EvolvesTo: []pokeclient.ChainLink{
+			{Species: pokeclient.Entry{Name: "vaporeon"}, EvolutionDetails: stone("water-stone")},
+			{Species: pokeclient.Entry{Name: "jolteon"}, EvolutionDetails: stone("thunder-stone")},
+			// Duplicate details from different games are only shown once
+			{Species: pokeclient.Entry{Name: "flareon"}, EvolutionDetails: append(stone("fire-stone"), stone("fire-stone")...)},
+		},
+	}
+
+	var out strings.Builder
+	printEvolutionChain(&out, chain)
+
+	expected := "eevee\n" +
+		"├── vaporeon (use water-stone)\n" +
+		"├── jolteon (use thunder-stone)\n" +
+		"└── flareon (use fire-stone)\n"
+	if out.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestCommandEvolutionsNoArgs(t *testing.T) {
+	config := &Config{
+		args: []string{},
+	}
+
+	err := commandEvolutions(context.Background(), config)
+	if err == nil {
+		t.Error("commandEvolutions should return error when no arguments provided")
+	}
+}
+
+func TestCommandEvolutionsUnknownPokemon(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{"fakemon"},
+		client: newTestClient(t, server.URL),
+	}
+
+	// Should not return error, just print message
+	err := commandEvolutions(context.Background(), config)
+	if err != nil {
+		t.Errorf("commandEvolutions should not return error for unknown pokemon, got %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+// filterEncounterVersions keeps the version details of the given version,
+// dropping pokemon that cannot be encountered in it. An empty version keeps
+// every version.
+func filterEncounterVersions(encounters []pokeclient.EncounterEntry, version string) []pokeclient.EncounterEntry {
+	if version == "" {
+		return encounters
+	}
+	var out []pokeclient.EncounterEntry
+	for _, encounter := range encounters {
+		for _, details := range encounter.VersionDetails {
+			if details.Version.Name == version {
+				encounter.VersionDetails = []pokeclient.VersionEncounterDetail{details}
+				out = append(out, encounter)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// printEncounters prints one row per pokemon, game version, method and set of
+// conditions.
+func printEncounters(w io.Writer, encounters []pokeclient.EncounterEntry) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "POKEMON\tVERSION\tMETHOD\tLEVELS\tCHANCE\tCONDITIONS")
+	for _, encounter := range encounters {
+		for _, details := range encounter.VersionDetails {
+			for _, summary := range pokeclient.SummarizeEncounters(details.EncounterDetails) {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d%%\t%s\n",
+					encounter.Pokemon.Name, details.Version.Name, summary.Method,
+					formatLevels(summary.MinLevel, summary.MaxLevel), summary.Chance, formatConditions(summary.Conditions))
+			}
+		}
+	}
+	tw.Flush()
+}
+
+func formatLevels(minLevel, maxLevel int) string {
+	if minLevel == maxLevel {
+		return fmt.Sprint(minLevel)
+	}
+	return fmt.Sprintf("%d-%d", minLevel, maxLevel)
+}
+
+func formatConditions(conditions []string) string {
+	if len(conditions) == 0 {
+		return "-"
+	}
+	return strings.Join(conditions, ", ")
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func versionEncounters(version string, encounters ...pokeclient.Encounter) pokeclient.VersionEncounterDetail {
+	return pokeclient.VersionEncounterDetail{Version: pokeclient.Entry{Name: version}, EncounterDetails: encounters}
+}
+
+var viridianForest = []pokeclient.EncounterEntry{
+	{
+		Pokemon: pokeclient.PokemonEntry{Name: "pikachu"},
+		VersionDetails: []pokeclient.VersionEncounterDetail{
+			versionEncounters("red", pokeclient.Encounter{MinLevel: 3, MaxLevel: 3, Chance: 5, Method: pokeclient.Entry{Name: "walk"}}),
+			versionEncounters("yellow", pokeclient.Encounter{MinLevel: 3, MaxLevel: 5, Chance: 10, Method: pokeclient.Entry{Name: "walk"}}),
+		},
+	},
+	{
+		Pokemon: pokeclient.PokemonEntry{Name: "caterpie"},
+		VersionDetails: []pokeclient.VersionEncounterDetail{
+			versionEncounters("red",
+				pokeclient.Encounter{MinLevel: 3, MaxLevel: 3, Chance: 20, Method: pokeclient.Entry{Name: "walk"}},
+				pokeclient.Encounter{MinLevel: 5, MaxLevel: 5, Chance: 15, Method: pokeclient.Entry{Name: "walk"}}),
+		},
+	},
+}
+
+func TestFilterEncounterVersions(t *testing.T) {
+	if filtered := filterEncounterVersions(viridianForest, ""); len(filtered) != 2 {
+		t.Errorf("Expected no filtering without a version, got %d pokemon", len(filtered))
+	}
+
+	filtered := filterEncounterVersions(viridianForest, "yellow")
+	if len(filtered) != 1 || filtered[0].Pokemon.Name != "pikachu" {
+		t.Fatalf("Expected only pikachu in yellow, got %+v", filtered)
+	}
+	if len(filtered[0].VersionDetails) != 1 || filtered[0].VersionDetails[0].Version.Name != "yellow" {
+		t.Errorf("Expected only the yellow details, got %+v", filtered[0].VersionDetails)
+	}
+}
+
+func TestPrintEncounters(t *testing.T) {
+	var out strings.Builder
+	printEncounters(&out, filterEncounterVersions(viridianForest, "red"))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected a header and 2 rows, got:\n%s", out.String())
+	}
+	if fields := strings.Fields(lines[1]); !slices.Equal(fields, []string{"pikachu", "red", "walk", "3", "5%", "-"}) {
+		t.Errorf("Unexpected pikachu row %v", fields)
+	}
+	if fields := strings.Fields(lines[2]); !slices.Equal(fields, []string{"caterpie", "red", "walk", "3-5", "35%", "-"}) {
+		t.Errorf("Unexpected caterpie row %v", fields)
+	}
+}
+
+func TestCommandExploreDetails(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name: "known area",
+			args: []string{"viridian-forest-area", "--details", "--version", "red"},
+			serverResponse: `{"pokemon_encounters": [{"pokemon": {"name": "pikachu"}, "version_details": [
+				{"version": {"name": "red"}, "encounter_details": [{"min_level": 3, "max_level": 3, "chance": 5, "method": {"name": "walk"}}]}
+			]}]}`,
+			serverStatus: http.StatusOK,
+		},
+		{
+			// Should not return error for unknown areas, just print message
+			name:         "unknown area - 404",
+			args:         []string{"nowhere", "--details"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"viridian-forest-area", "--details"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"viridian-forest-area", "--details"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:        "no area",
+			args:        []string{"--details"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandExplore(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+	"github.com/jabreu610/pokedexcli/internal/repl"
+)
+
+// gameSetting is the game chosen with the game command. Commands that show
+// per-game data only show this game unless told otherwise.
+type gameSetting struct {
+	version      string
+	versionGroup string
+	generation   string
+}
+
+func commandGame(ctx context.Context, c *Config) error {
+	args := repl.ParseArgs(c.args, "clear")
+	if args.Has("clear") {
+		c.game = nil
+		fmt.Println("Showing data for every game")
+		return nil
+	}
+	if len(args.Positional) < 1 {
+		if c.game == nil {
+			fmt.Println("No game selected, usage: game <version> or game --clear")
+			return nil
+		}
+		fmt.Printf("Game: %s (%s, %s)\n", c.game.version, c.game.versionGroup, c.game.generation)
+		return nil
+	}
+
+	version, err := c.client.GetVersion(ctx, args.Positional[0])
+	if errors.Is(err, pokeclient.ErrVersionNotFound) {
+		fmt.Printf("Game %s does not exist\n", args.Positional[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	group, err := c.client.GetVersionGroup(ctx, version.VersionGroup.Name)
+	if err != nil {
+		return err
+	}
+	c.game = &gameSetting{
+		version:      version.Name,
+		versionGroup: group.Name,
+		generation:   group.Generation.Name,
+	}
+	fmt.Printf("Showing data for %s\n", version.Name)
+	return nil
+}
+
+// versionFlag returns the --version flag, falling back to the session game.
+// It is empty when neither is set.
+func versionFlag(args repl.Args, c *Config) string {
+	if version, ok := args.Flag("version"); ok {
+		return version
+	}
+	if c.game != nil {
+		return c.game.version
+	}
+	return ""
+}
+
+func prompt(c *Config) string {
+	if c.game == nil {
+		return "Pokedex > "
+	}
+	return fmt.Sprintf("Pokedex (%s) > ", c.game.version)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/repl"
+)
+
+func TestCommandGame(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version/red":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "red", "version_group": {"name": "red-blue"}}`))
+		case "/version-group/red-blue":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "red-blue", "generation": {"name": "generation-i"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{"red"},
+		client: newTestClient(t, server.URL),
+	}
+	if prompt(config) != "Pokedex > " {
+		t.Errorf("Expected the plain prompt without a game, got %q", prompt(config))
+	}
+
+	if err := commandGame(context.Background(), config); err != nil {
+		t.Fatalf("commandGame should not return error, got %v", err)
+	}
+	if config.game == nil || config.game.versionGroup != "red-blue" || config.game.generation != "generation-i" {
+		t.Fatalf("Expected red-blue in generation-i, got %+v", config.game)
+	}
+	if prompt(config) != "Pokedex (red) > " {
+		t.Errorf("Expected the prompt to show the game, got %q", prompt(config))
+	}
+
+	// Should not return error or change the game for unknown versions
+	config.args = []string{"purple"}
+	if err := commandGame(context.Background(), config); err != nil {
+		t.Errorf("commandGame should not return error for unknown game, got %v", err)
+	}
+	if config.game == nil || config.game.version != "red" {
+		t.Errorf("Expected an unknown game to keep red, got %+v", config.game)
+	}
+
+	config.args = []string{"--clear"}
+	if err := commandGame(context.Background(), config); err != nil {
+		t.Errorf("commandGame should not return error, got %v", err)
+	}
+	if config.game != nil {
+		t.Errorf("Expected --clear to unset the game, got %+v", config.game)
+	}
+}
+
+func TestVersionFlag(t *testing.T) {
+	config := &Config{}
+	if version := versionFlag(repl.ParseArgs([]string{"pikachu"}), config); version != "" {
+		t.Errorf("Expected no version without a game, got %q", version)
+	}
+
+	config.game = &gameSetting{version: "red", versionGroup: "red-blue"}
+	if version := versionFlag(repl.ParseArgs([]string{"pikachu"}), config); version != "red" {
+		t.Errorf("Expected the session game, got %q", version)
+	}
+	if version := versionFlag(repl.ParseArgs([]string{"pikachu", "--version", "gold"}), config); version != "gold" {
+		t.Errorf("Expected --version to override the session game, got %q", version)
+	}
+}
@@ -6,33 +6,66 @@ import (
 	"time"
 )
 
+// Validators identify the version of a cached response so it can be
+// revalidated with the server once it goes stale.
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+func (v Validators) Empty() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
 type cacheEntry struct {
-	createdAt time.Time
-	val       []byte
+	createdAt  time.Time
+	val        []byte
+	validators Validators
 }
 
-func newCacheEntry(val []byte) cacheEntry {
+func newCacheEntry(val []byte, validators Validators) cacheEntry {
 	return cacheEntry{
-		val:       val,
-		createdAt: time.Now(),
+		val:        val,
+		validators: validators,
+		createdAt:  time.Now(),
 	}
 }
 
 type Cache struct {
-	store  map[string]cacheEntry
-	mu     sync.RWMutex
-	cancel context.CancelFunc
+	store          map[string]cacheEntry
+	mu             sync.RWMutex
+	cancel         context.CancelFunc
+	interval       time.Duration
+	staleRetention time.Duration
+}
+
+// Option configures a Cache in NewCache.
+type Option func(*Cache)
+
+// WithStaleRetention sets how long expired entries that have validators are
+// kept for revalidation. It defaults to twelve times the reap interval.
+func WithStaleRetention(d time.Duration) Option {
+	return func(c *Cache) {
+		c.staleRetention = d
+	}
 }
 
 func (c *Cache) Add(key string, val []byte) {
+	c.AddWithValidators(key, val, Validators{})
+}
+
+// AddWithValidators stores val like Add, and keeps it past its expiry for
+// revalidation when validators is not empty.
+func (c *Cache) AddWithValidators(key string, val []byte, validators Validators) {
 	if c == nil {
 		return
 	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.store[key] = newCacheEntry(val)
+	c.store[key] = newCacheEntry(val, validators)
 }
 
+// Get returns the value stored for key if it has not expired yet.
 func (c *Cache) Get(key string) (val []byte, ok bool) {
 	if c == nil {
 		return nil, false
@@ -40,34 +73,75 @@ func (c *Cache) Get(key string) (val []byte, ok bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	entry, ok := c.store[key]
-	if !ok {
-		return nil, ok
+	if !ok || time.Since(entry.createdAt) >= c.interval {
+		return nil, false
 	}
 	return entry.val, ok
 }
 
+// GetStale returns the value stored for key and its validators, whether it
+// has expired or not.
+func (c *Cache) GetStale(key string) (val []byte, validators Validators, ok bool) {
+	if c == nil {
+		return nil, Validators{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.store[key]
+	if !ok {
+		return nil, Validators{}, ok
+	}
+	return entry.val, entry.validators, ok
+}
+
+// Refresh marks the entry for key as fresh again, for example after the
+// server confirmed it is unchanged. It reports whether the entry exists.
+func (c *Cache) Refresh(key string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.store[key]
+	if !ok {
+		return false
+	}
+	entry.createdAt = time.Now()
+	c.store[key] = entry
+	return true
+}
+
 func (c *Cache) Close() {
 	if c != nil && c.cancel != nil {
 		c.cancel()
 	}
 }
 
-func (c *Cache) reapLoop(min time.Time) {
+func (c *Cache) reapLoop(now time.Time) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	expired := now.Add(c.interval * -1)
+	staleExpired := expired.Add(c.staleRetention * -1)
 	for key, entry := range c.store {
-		if entry.createdAt.Before(min) {
+		if entry.validators.Empty() && entry.createdAt.Before(expired) {
+			delete(c.store, key)
+		} else if entry.createdAt.Before(staleExpired) {
 			delete(c.store, key)
 		}
 	}
 }
 
-func NewCache(interval time.Duration, parentCtx context.Context) *Cache {
+func NewCache(interval time.Duration, parentCtx context.Context, opts ...Option) *Cache {
 	ctx, cancel := context.WithCancel(parentCtx)
 	c := Cache{
-		store:  map[string]cacheEntry{},
-		cancel: cancel,
+		store:          map[string]cacheEntry{},
+		cancel:         cancel,
+		interval:       interval,
+		staleRetention: interval * 12,
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
 	ticker := time.NewTicker(interval)
 
@@ -77,7 +151,7 @@ func NewCache(interval time.Duration, parentCtx context.Context) *Cache {
 		for {
 			select {
 			case <-ticker.C:
-				c.reapLoop(time.Now().Add(interval * -1))
+				c.reapLoop(time.Now())
 			case <-ctx.Done():
 				break CacheLoop
 			}
@@ -226,3 +226,116 @@ func TestNilCacheClose(t *testing.T) {
 	cache.Close()
 	// If we get here without panicking, test passes
 }
+
+func TestCacheGetSkipsExpiredEntries(t *testing.T) {
+	interval := 50 * time.Millisecond
+	cache := pokecache.NewCache(time.Hour, context.Background())
+	defer cache.Close()
+	short := pokecache.NewCache(interval, context.Background())
+	defer short.Close()
+
+	cache.Add("key", []byte("value"))
+	short.AddWithValidators("key", []byte("value"), pokecache.Validators{ETag: `"v1"`})
+
+	time.Sleep(interval + 10*time.Millisecond)
+
+	if _, ok := cache.Get("key"); !ok {
+		t.Error("Fresh entry should be returned")
+	}
+	if _, ok := short.Get("key"); ok {
+		t.Error("Expired entry should not be returned by Get")
+	}
+}
+
+func TestCacheKeepsStaleEntriesWithValidators(t *testing.T) {
+	interval := 50 * time.Millisecond
+	cache := pokecache.NewCache(interval, context.Background())
+	defer cache.Close()
+
+	validators := pokecache.Validators{ETag: `"abc"`, LastModified: "Wed, 21 Oct 2015 07:28:00 GMT"}
+	cache.AddWithValidators("with-validators", []byte("kept"), validators)
+	cache.Add("without-validators", []byte("reaped"))
+
+	// Wait for at least one reap
+	time.Sleep(2*interval + 20*time.Millisecond)
+
+	if _, _, ok := cache.GetStale("without-validators"); ok {
+		t.Error("Entry without validators should have been reaped")
+	}
+	val, got, ok := cache.GetStale("with-validators")
+	if !ok {
+		t.Fatal("Entry with validators should be kept for revalidation")
+	}
+	if string(val) != "kept" {
+		t.Errorf("Expected value 'kept', got %s", val)
+	}
+	if got != validators {
+		t.Errorf("Expected validators %+v, got %+v", validators, got)
+	}
+}
+
+func TestCacheStaleRetention(t *testing.T) {
+	interval := 20 * time.Millisecond
+	cache := pokecache.NewCache(interval, context.Background(), pokecache.WithStaleRetention(interval))
+	defer cache.Close()
+
+	cache.AddWithValidators("key", []byte("value"), pokecache.Validators{ETag: `"v1"`})
+
+	time.Sleep(4 * interval)
+
+	if _, _, ok := cache.GetStale("key"); ok {
+		t.Error("Stale entry should be reaped after the retention period")
+	}
+}
+
+func TestCacheRefresh(t *testing.T) {
+	interval := 50 * time.Millisecond
+	cache := pokecache.NewCache(interval, context.Background())
+	defer cache.Close()
+
+	if cache.Refresh("missing") {
+		t.Error("Refresh should report false for a missing key")
+	}
+
+	cache.AddWithValidators("key", []byte("value"), pokecache.Validators{ETag: `"v1"`})
+	time.Sleep(interval + 10*time.Millisecond)
+	if _, ok := cache.Get("key"); ok {
+		t.Fatal("Entry should have expired")
+	}
+
+	if !cache.Refresh("key") {
+		t.Fatal("Refresh should report true for a stored key")
+	}
+	val, ok := cache.Get("key")
+	if !ok {
+		t.Fatal("Refreshed entry should be fresh again")
+	}
+	if string(val) != "value" {
+		t.Errorf("Expected value 'value', got %s", val)
+	}
+}
+
+func TestValidatorsEmpty(t *testing.T) {
+	if !(pokecache.Validators{}).Empty() {
+		t.Error("Zero Validators should be empty")
+	}
+	if (pokecache.Validators{ETag: `"v1"`}).Empty() {
+		t.Error("Validators with an ETag should not be empty")
+	}
+	if (pokecache.Validators{LastModified: "Wed, 21 Oct 2015 07:28:00 GMT"}).Empty() {
+		t.Error("Validators with Last-Modified should not be empty")
+	}
+}
+
+func TestNilCacheValidators(t *testing.T) {
+	var cache *pokecache.Cache
+
+	// None of these should panic on nil cache
+	cache.AddWithValidators("key", []byte("value"), pokecache.Validators{ETag: `"v1"`})
+	if _, _, ok := cache.GetStale("key"); ok {
+		t.Error("GetStale should return false for nil cache")
+	}
+	if cache.Refresh("key") {
+		t.Error("Refresh should return false for nil cache")
+	}
+}
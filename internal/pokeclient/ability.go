@@ -0,0 +1,58 @@
+package pokeclient
+
+import "context"
+
+type PokemonAbility struct {
+	Ability  Entry `json:"ability"`
+	IsHidden bool  `json:"is_hidden"`
+	Slot     int   `json:"slot"`
+}
+
+// AbilityPokemon is a pokemon that can have an ability.
+type AbilityPokemon struct {
+	Pokemon  Entry `json:"pokemon"`
+	IsHidden bool  `json:"is_hidden"`
+	Slot     int   `json:"slot"`
+}
+
+type AbilityFlavorText struct {
+	FlavorText   string `json:"flavor_text"`
+	Language     Entry  `json:"language"`
+	VersionGroup Entry  `json:"version_group"`
+}
+
+type Ability struct {
+	ID                int                 `json:"id"`
+	Name              string              `json:"name"`
+	Generation        Entry               `json:"generation"`
+	EffectEntries     []EffectEntry       `json:"effect_entries"`
+	FlavorTextEntries []AbilityFlavorText `json:"flavor_text_entries"`
+	Pokemon           []AbilityPokemon    `json:"pokemon"`
+	Names             []Name              `json:"names"`
+}
+
+// LocalizedName returns the name of the ability in the given language,
+// falling back to Name.
+func (a Ability) LocalizedName(language string) string {
+	return localizedName(a.Names, language, a.Name)
+}
+
+// Description returns the full effect text in the given language. Abilities
+// added in recent games only have in-game flavor text, so the newest flavor
+// text is used when there is no effect text.
+func (a Ability) Description(language string) string {
+	if description := effectText(a.EffectEntries, language, false); description != "" {
+		return description
+	}
+	description := ""
+	for _, entry := range a.FlavorTextEntries {
+		if entry.Language.Name == language {
+			description = cleanFlavorText(entry.FlavorText)
+		}
+	}
+	return description
+}
+
+func (c *Client) GetAbility(ctx context.Context, name string) (Ability, error) {
+	return fetchResource[Ability](ctx, c, ErrAbilityNotFound, "ability", name)
+}
@@ -0,0 +1,167 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const staticAbility = `{
+	"id": 9,
+	"name": "static",
+	"generation": {"name": "generation-iii"},
+	"effect_entries": [
+		{"effect": "Whenever a move makes contact with this Pokémon,\nthe move's user has a 30% chance of being paralyzed.", "short_effect": "Has a 30% chance of paralyzing attacking Pokémon on contact.", "language": {"name": "en"}}
+	],
+	"pokemon": [
+		{"is_hidden": false, "slot": 1, "pokemon": {"name": "pikachu"}},
+		{"is_hidden": true, "slot": 3, "pokemon": {"name": "electrode"}}
+	]
+}`
+
+func TestGetAbility(t *testing.T) {
+	tests := []struct {
+		name                string
+		abilityName         string
+		serverResponse      string
+		serverStatus        int
+		expectError         bool
+		expectNotFoundErr   bool
+		expectedDescription string
+		expectedHidden      []string
+	}{
+		{
+			name:                "successful response",
+			abilityName:         "static",
+			serverResponse:      staticAbility,
+			serverStatus:        http.StatusOK,
+			expectedDescription: "Whenever a move makes contact with this Pokémon, the move's user has a 30% chance of being paralyzed.",
+			expectedHidden:      []string{"electrode"},
+		},
+		{
+			name:           "ability without effect entries",
+			abilityName:    "as-one-glastrier",
+			serverResponse: `{"id": 266, "name": "as-one-glastrier", "effect_entries": [], "pokemon": [{"is_hidden": false, "slot": 1, "pokemon": {"name": "calyrex-ice"}}]}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			name:              "ability not found - 404",
+			abilityName:       "wonder-skin-2",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			abilityName:  "static",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			abilityName:    "static",
+			serverResponse: `{"name": "static", "pokemon": {"name": "pikachu"}}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			abilityName:    "static",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			ability, err := client.GetAbility(context.Background(), tt.abilityName)
+
+			if requestedPath != "/ability/"+tt.abilityName {
+				t.Errorf("Expected request path /ability/%s, got %s", tt.abilityName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrAbilityNotFound) {
+				t.Errorf("Expected ErrAbilityNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if ability.Name != tt.abilityName {
+					t.Errorf("Expected ability %s, got %s", tt.abilityName, ability.Name)
+				}
+				if description := ability.Description("en"); description != tt.expectedDescription {
+					t.Errorf("Expected description %q, got %q", tt.expectedDescription, description)
+				}
+				var hidden []string
+				for _, pokemon := range ability.Pokemon {
+					if pokemon.IsHidden {
+						hidden = append(hidden, pokemon.Pokemon.Name)
+					}
+				}
+				if !slices.Equal(hidden, tt.expectedHidden) {
+					t.Errorf("Expected %v to have %s as a hidden ability, got %v", tt.expectedHidden, tt.abilityName, hidden)
+				}
+			}
+		})
+	}
+}
+
+func TestAbilityDescriptionFallsBackToFlavorText(t *testing.T) {
+	ability := pokeclient.Ability{
+		FlavorTextEntries: []pokeclient.AbilityFlavorText{
+			{FlavorText: "Old text.", Language: pokeclient.Entry{Name: "en"}},
+			{FlavorText: "Boosts the\nPokémon's Speed.", Language: pokeclient.Entry{Name: "en"}},
+			{FlavorText: "Augmente la Vitesse.", Language: pokeclient.Entry{Name: "fr"}},
+		},
+	}
+	if description := ability.Description("en"); description != "Boosts the Pokémon's Speed." {
+		t.Errorf("Expected the newest flavor text, got %q", description)
+	}
+}
+
+func TestPokemonAbilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"name": "pikachu",
+			"abilities": [
+				{"ability": {"name": "static"}, "is_hidden": false, "slot": 1},
+				{"ability": {"name": "lightning-rod"}, "is_hidden": true, "slot": 3}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	pokemon, err := client.GetPokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pokemon.Abilities) != 2 {
+		t.Fatalf("Expected 2 abilities, got %d", len(pokemon.Abilities))
+	}
+	hidden := pokemon.Abilities[1]
+	if hidden.Ability.Name != "lightning-rod" || !hidden.IsHidden || hidden.Slot != 3 {
+		t.Errorf("Expected hidden lightning-rod in slot 3, got %+v", hidden)
+	}
+}
@@ -0,0 +1,59 @@
+package pokeclient
+
+import (
+	"context"
+	"sync"
+)
+
+const DefaultBatchConcurrency = 4
+
+// WithBatchConcurrency sets how many lookups a batch runs at once. Values
+// below 1 are treated as 1.
+func WithBatchConcurrency(n int) Option {
+	return func(c *Client) {
+		c.batchConcurrency = max(n, 1)
+	}
+}
+
+// Result is the outcome of one lookup in a batch.
+type Result[T any] struct {
+	Key   string
+	Value T
+	Err   error
+}
+
+// Batch calls get for every key with at most the client's batch concurrency
+// in flight, and returns one Result per key in the order of keys. A failed
+// lookup only sets Err of its own Result. Once ctx is cancelled, lookups that
+// have not started yet fail with ctx.Err().
+func Batch[T any](ctx context.Context, c *Client, keys []string, get func(context.Context, string) (T, error)) []Result[T] {
+	results := make([]Result[T], len(keys))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(c.batchConcurrency, len(keys)) {
+		wg.Go(func() {
+			for i := range indexes {
+				results[i].Key = keys[i]
+				if err := ctx.Err(); err != nil {
+					results[i].Err = err
+					continue
+				}
+				results[i].Value, results[i].Err = get(ctx, keys[i])
+			}
+		})
+	}
+	for i := range keys {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// GetPokemonBatch looks up every name like GetPokemon, sharing its cache and
+// rate limit, and returns the results in the order of names.
+func (c *Client) GetPokemonBatch(ctx context.Context, names []string) []Result[Pokemon] {
+	return Batch(ctx, c, names, c.GetPokemon)
+}
@@ -0,0 +1,133 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+// newBatchServer serves any pokemon by name except "missingno", and records
+// the highest number of requests it handled at once.
+func newBatchServer(delay time.Duration, maxInFlight *atomic.Int32) *httptest.Server {
+	var inFlight atomic.Int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		time.Sleep(delay)
+
+		name := strings.TrimPrefix(r.URL.Path, "/pokemon/")
+		if name == "missingno" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "` + name + `", "base_experience": 1}`))
+	}))
+}
+
+func TestGetPokemonBatch(t *testing.T) {
+	var maxInFlight atomic.Int32
+	server := newBatchServer(20*time.Millisecond, &maxInFlight)
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithBatchConcurrency(3),
+		pokeclient.WithRateLimit(0, 0),
+	)
+
+	names := []string{"bulbasaur", "ivysaur", "missingno", "venusaur", "charmander", "charmeleon", "charizard"}
+	results := client.GetPokemonBatch(context.Background(), names)
+
+	if len(results) != len(names) {
+		t.Fatalf("Expected %d results, got %d", len(names), len(results))
+	}
+	for i, result := range results {
+		if result.Key != names[i] {
+			t.Errorf("Expected result %d to be for %s, got %s", i, names[i], result.Key)
+		}
+		if names[i] == "missingno" {
+			if !errors.Is(result.Err, pokeclient.ErrPokemonNotFound) {
+				t.Errorf("Expected ErrPokemonNotFound for missingno, got %v", result.Err)
+			}
+			continue
+		}
+		if result.Err != nil {
+			t.Errorf("Expected no error for %s, got %v", names[i], result.Err)
+		}
+		if result.Value.Name != names[i] {
+			t.Errorf("Expected pokemon %s, got %s", names[i], result.Value.Name)
+		}
+	}
+	if maxInFlight.Load() > 3 {
+		t.Errorf("Expected at most 3 concurrent requests, got %d", maxInFlight.Load())
+	}
+	if maxInFlight.Load() < 2 {
+		t.Errorf("Expected lookups to run concurrently, got %d at most", maxInFlight.Load())
+	}
+}
+
+func TestGetPokemonBatchUsesCache(t *testing.T) {
+	var maxInFlight atomic.Int32
+	server := newBatchServer(0, &maxInFlight)
+	defer server.Close()
+
+	cache := pokecache.NewCache(5*time.Second, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+	if _, err := client.GetPokemon(context.Background(), "mew"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	results := client.GetPokemonBatch(context.Background(), []string{"mew", "mewtwo"})
+	for _, result := range results {
+		if result.Err != nil {
+			t.Errorf("Expected no error for %s, got %v", result.Key, result.Err)
+		}
+	}
+	if requests := client.Stats().Requests; requests != 2 {
+		t.Errorf("Expected cached pokemon not to be requested again (2 requests), got %d", requests)
+	}
+}
+
+func TestGetPokemonBatchCancelled(t *testing.T) {
+	var maxInFlight atomic.Int32
+	server := newBatchServer(0, &maxInFlight)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := client.GetPokemonBatch(ctx, []string{"mew", "mewtwo"})
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("Expected context.Canceled for %s, got %v", result.Key, result.Err)
+		}
+	}
+	if requests := client.Stats().Requests; requests != 0 {
+		t.Errorf("Expected no requests after cancellation, got %d", requests)
+	}
+}
+
+func TestGetPokemonBatchEmpty(t *testing.T) {
+	client := pokeclient.NewClient()
+	if results := client.GetPokemonBatch(context.Background(), nil); len(results) != 0 {
+		t.Errorf("Expected no results, got %d", len(results))
+	}
+}
@@ -0,0 +1,47 @@
+package pokeclient
+
+import "context"
+
+// BerryFlavorPotency is how strongly a berry has a flavor.
+type BerryFlavorPotency struct {
+	Potency int   `json:"potency"`
+	Flavor  Entry `json:"flavor"`
+}
+
+type Berry struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// GrowthTime is the number of hours each of the four growth stages takes.
+	GrowthTime  int `json:"growth_time"`
+	MaxHarvest  int `json:"max_harvest"`
+	SoilDryness int `json:"soil_dryness"`
+	// Size is in millimeters.
+	Size             int                  `json:"size"`
+	Smoothness       int                  `json:"smoothness"`
+	Firmness         Entry                `json:"firmness"`
+	NaturalGiftPower int                  `json:"natural_gift_power"`
+	NaturalGiftType  Entry                `json:"natural_gift_type"`
+	Flavors          []BerryFlavorPotency `json:"flavors"`
+	Item             Entry                `json:"item"`
+}
+
+// FlavorBerry is how strongly a berry has a flavor, seen from the flavor.
+type FlavorBerry struct {
+	Potency int   `json:"potency"`
+	Berry   Entry `json:"berry"`
+}
+
+type BerryFlavor struct {
+	ID          int           `json:"id"`
+	Name        string        `json:"name"`
+	Berries     []FlavorBerry `json:"berries"`
+	ContestType Entry         `json:"contest_type"`
+}
+
+func (c *Client) GetBerry(ctx context.Context, name string) (Berry, error) {
+	return fetchResource[Berry](ctx, c, ErrBerryNotFound, "berry", name)
+}
+
+func (c *Client) GetBerryFlavor(ctx context.Context, name string) (BerryFlavor, error) {
+	return fetchResource[BerryFlavor](ctx, c, ErrBerryFlavorNotFound, "berry-flavor", name)
+}
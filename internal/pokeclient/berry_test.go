@@ -0,0 +1,221 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const cheriBerry = `{
+	"id": 1, "name": "cheri", "growth_time": 3, "max_harvest": 5, "soil_dryness": 15,
+	"size": 20, "smoothness": 25, "firmness": {"name": "soft"},
+	"natural_gift_power": 60, "natural_gift_type": {"name": "fire"},
+	"flavors": [{"potency": 10, "flavor": {"name": "spicy"}}, {"potency": 0, "flavor": {"name": "dry"}}],
+	"item": {"name": "cheri-berry"}
+}`
+
+func TestGetBerry(t *testing.T) {
+	tests := []struct {
+		name                     string
+		berryName                string
+		serverResponse           string
+		serverStatus             int
+		expectError              bool
+		expectNotFoundErr        bool
+		expectedGrowthTime       int
+		expectedFirmness         string
+		expectedNaturalGiftPower int
+		expectedNaturalGiftType  string
+		expectedFlavors          []string
+	}{
+		{
+			name:                     "successful response",
+			berryName:                "cheri",
+			serverResponse:           cheriBerry,
+			serverStatus:             http.StatusOK,
+			expectedGrowthTime:       3,
+			expectedFirmness:         "soft",
+			expectedNaturalGiftPower: 60,
+			expectedNaturalGiftType:  "fire",
+			expectedFlavors:          []string{"spicy", "dry"},
+		},
+		{
+			name:                     "berry without flavors",
+			berryName:                "enigma",
+			serverResponse:           `{"id": 60, "name": "enigma", "growth_time": 24, "firmness": {"name": "hard"}, "natural_gift_power": 80, "natural_gift_type": {"name": "bug"}, "flavors": []}`,
+			serverStatus:             http.StatusOK,
+			expectedGrowthTime:       24,
+			expectedFirmness:         "hard",
+			expectedNaturalGiftPower: 80,
+			expectedNaturalGiftType:  "bug",
+		},
+		{
+			name:              "berry not found - 404",
+			berryName:         "fake",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			berryName:    "cheri",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			berryName:      "cheri",
+			serverResponse: `{"name": "cheri", "growth_time": "3 hours"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			berryName:      "cheri",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			berry, err := client.GetBerry(context.Background(), tt.berryName)
+
+			if requestedPath != "/berry/"+tt.berryName {
+				t.Errorf("Expected request path /berry/%s, got %s", tt.berryName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrBerryNotFound) {
+				t.Errorf("Expected ErrBerryNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if berry.GrowthTime != tt.expectedGrowthTime || berry.Firmness.Name != tt.expectedFirmness {
+					t.Errorf("Expected a %s berry growing in %d hours, got %s in %d", tt.expectedFirmness, tt.expectedGrowthTime, berry.Firmness.Name, berry.GrowthTime)
+				}
+				if berry.NaturalGiftPower != tt.expectedNaturalGiftPower || berry.NaturalGiftType.Name != tt.expectedNaturalGiftType {
+					t.Errorf("Expected a %d power %s natural gift, got %d %s", tt.expectedNaturalGiftPower, tt.expectedNaturalGiftType, berry.NaturalGiftPower, berry.NaturalGiftType.Name)
+				}
+				var flavors []string
+				for _, flavor := range berry.Flavors {
+					flavors = append(flavors, flavor.Flavor.Name)
+				}
+				if !slices.Equal(flavors, tt.expectedFlavors) {
+					t.Errorf("Expected flavors %v, got %v", tt.expectedFlavors, flavors)
+				}
+			}
+		})
+	}
+}
+
+func TestGetBerryFlavor(t *testing.T) {
+	tests := []struct {
+		name                string
+		flavorName          string
+		serverResponse      string
+		serverStatus        int
+		expectError         bool
+		expectNotFoundErr   bool
+		expectedContestType string
+		expectedBerries     []string
+	}{
+		{
+			name:       "successful response",
+			flavorName: "spicy",
+			serverResponse: `{"id": 1, "name": "spicy", "contest_type": {"name": "cool"}, "berries": [
+				{"potency": 10, "berry": {"name": "cheri"}}, {"potency": 40, "berry": {"name": "tamato"}}
+			]}`,
+			serverStatus:        http.StatusOK,
+			expectedContestType: "cool",
+			expectedBerries:     []string{"cheri", "tamato"},
+		},
+		{
+			name:              "flavor not found - 404",
+			flavorName:        "umami",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			flavorName:   "spicy",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			flavorName:     "spicy",
+			serverResponse: `{"name": "spicy", "berries": [{"potency": "high"}]}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			flavorName:     "spicy",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			flavor, err := client.GetBerryFlavor(context.Background(), tt.flavorName)
+
+			if requestedPath != "/berry-flavor/"+tt.flavorName {
+				t.Errorf("Expected request path /berry-flavor/%s, got %s", tt.flavorName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrBerryFlavorNotFound) {
+				t.Errorf("Expected ErrBerryFlavorNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if flavor.ContestType.Name != tt.expectedContestType {
+					t.Errorf("Expected contest type %s, got %s", tt.expectedContestType, flavor.ContestType.Name)
+				}
+				var berries []string
+				for _, berry := range flavor.Berries {
+					berries = append(berries, berry.Berry.Name)
+				}
+				if !slices.Equal(berries, tt.expectedBerries) {
+					t.Errorf("Expected berries %v, got %v", tt.expectedBerries, berries)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package pokeclient
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+)
+
+const (
+	DefaultBaseURL   = "https://pokeapi.co/api/v2"
+	DefaultUserAgent = "pokedexcli"
+	DefaultTimeout   = 10 * time.Second
+)
+
+// Client talks to a PokeAPI instance. The zero value is not usable, construct
+// one with NewClient.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	cache      *pokecache.Cache
+	userAgent  string
+	timeout    *time.Duration
+	retry      RetryPolicy
+	limiter    *limiter
+	logger     *slog.Logger
+	stats      clientStats
+	flights    flightGroup
+
+	typeChart    typeChartCache
+	speciesNames speciesNameIndex
+
+	maxResponseSize  int64
+	batchConcurrency int
+}
+
+// Option configures a Client in NewClient.
+type Option func(*Client)
+
+// WithBaseURL points the client at a PokeAPI instance other than pokeapi.co,
+// for example a self-hosted mirror. The URL should include the /api/v2 prefix.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient sets the http.Client used for requests. The client is copied,
+// so it is not modified by WithTimeout. Its own Timeout is kept unless
+// WithTimeout is also given.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithCache sets the cache used to store raw responses. A nil cache disables
+// caching.
+func WithCache(cache *pokecache.Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the overall timeout of a single request, including reading
+// the response body. Zero means no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = &timeout
+	}
+}
+
+// WithLogger sets the logger that receives debug output such as retries.
+// Output is discarded by default.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+func NewClient(opts ...Option) *Client {
+	c := Client{
+		baseURL:   DefaultBaseURL,
+		userAgent: DefaultUserAgent,
+		retry:     DefaultRetryPolicy,
+		limiter:   newLimiter(DefaultRequestsPerSecond, DefaultBurst),
+		logger:    slog.New(slog.DiscardHandler),
+
+		maxResponseSize:  DefaultMaxResponseSize,
+		batchConcurrency: DefaultBatchConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	httpClient := http.Client{Timeout: DefaultTimeout}
+	if c.httpClient != nil {
+		httpClient = *c.httpClient
+	}
+	if c.timeout != nil {
+		httpClient.Timeout = *c.timeout
+	}
+	c.httpClient = &httpClient
+
+	return &c
+}
+
+// BaseURL returns the PokeAPI root the client sends requests to.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// endpoint returns the URL of a resource, escaping each path segment so user
+// input such as "#0" or "?x" stays in its segment. Escaping leaves dot
+// segments as they are, use fetchResource for names from user input.
+func (c *Client) endpoint(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		escaped[i] = url.PathEscape(part)
+	}
+	return c.baseURL + "/" + strings.Join(escaped, "/")
+}
+
+// get sends a GET request with the extra header, retrying transient failures
+// according to the retry policy. The caller must close the response body.
+func (c *Client) get(ctx context.Context, url string, header http.Header) (*http.Response, error) {
+	attempts := max(c.retry.MaxAttempts, 1)
+	for attempt := 1; ; attempt++ {
+		res, err := c.attempt(ctx, url, header)
+		if attempt == attempts || ctx.Err() != nil {
+			return res, err
+		}
+
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if !retryableError(err) {
+				return nil, err
+			}
+			delay = c.retry.backoff(attempt)
+			c.logger.DebugContext(ctx, "retrying request",
+				"url", url, "attempt", attempt, "max_attempts", attempts, "error", err, "delay", delay)
+		case retryableStatus(res.StatusCode):
+			delay = c.retry.backoff(attempt)
+			if after, ok := retryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				if c.retry.MaxDelay > 0 && after > c.retry.MaxDelay {
+					// The server wants us to wait longer than we are willing to.
+					return res, nil
+				}
+				delay = after
+			}
+			c.logger.DebugContext(ctx, "retrying request",
+				"url", url, "attempt", attempt, "max_attempts", attempts, "status", res.StatusCode, "delay", delay)
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		default:
+			if attempt > 1 {
+				c.logger.DebugContext(ctx, "request succeeded after retries", "url", url, "attempts", attempt)
+			}
+			return res, nil
+		}
+
+		c.stats.retries.Add(1)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, url string, header http.Header) (*http.Response, error) {
+	waited, err := c.limiter.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if waited > 0 {
+		c.stats.throttled.Add(1)
+		c.stats.throttleWait.Add(int64(waited))
+		c.logger.DebugContext(ctx, "request throttled", "url", url, "waited", waited)
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if c.retry.PerAttemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.retry.PerAttemptTimeout)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	c.stats.requests.Add(1)
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
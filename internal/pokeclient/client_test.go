@@ -0,0 +1,118 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestNewClientDefaults(t *testing.T) {
+	client := pokeclient.NewClient()
+	if client.BaseURL() != pokeclient.DefaultBaseURL {
+		t.Errorf("Expected base URL %s, got %s", pokeclient.DefaultBaseURL, client.BaseURL())
+	}
+}
+
+func TestWithBaseURLTrimsTrailingSlash(t *testing.T) {
+	client := pokeclient.NewClient(pokeclient.WithBaseURL("https://mirror.example.com/api/v2/"))
+	if client.BaseURL() != "https://mirror.example.com/api/v2" {
+		t.Errorf("Expected trailing slash to be trimmed, got %s", client.BaseURL())
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	userAgent, accept := "", ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		accept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "eevee", "base_experience": 65}`))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithUserAgent("pokedexcli-test/1.0"),
+	)
+	if _, err := client.GetPokemon(context.Background(), "eevee"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if userAgent != "pokedexcli-test/1.0" {
+		t.Errorf("Expected User-Agent 'pokedexcli-test/1.0', got %q", userAgent)
+	}
+	if accept != "application/json" {
+		t.Errorf("Expected Accept 'application/json', got %q", accept)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithHTTPClient(&http.Client{}),
+		pokeclient.WithTimeout(50*time.Millisecond),
+	)
+	if _, err := client.GetPokemon(context.Background(), "slowpoke"); err == nil {
+		t.Error("Expected timeout error but got none")
+	}
+}
+
+func TestClientsAreIndependent(t *testing.T) {
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "` + name + `", "base_experience": 1}`))
+		}))
+	}
+	first := newServer("first")
+	defer first.Close()
+	second := newServer("second")
+	defer second.Close()
+
+	firstClient := pokeclient.NewClient(pokeclient.WithBaseURL(first.URL))
+	secondClient := pokeclient.NewClient(pokeclient.WithBaseURL(second.URL))
+
+	p1, err := firstClient.GetPokemon(context.Background(), "ditto")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	p2, err := secondClient.GetPokemon(context.Background(), "ditto")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if p1.Name != "first" || p2.Name != "second" {
+		t.Errorf("Expected each client to use its own server, got %s and %s", p1.Name, p2.Name)
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.GetPokemonForLocationName(ctx, "mt-moon-1f")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package pokeclient
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup coalesces concurrent downloads of the same URL. The first
+// caller starts the download and later callers wait for its result instead
+// of sending their own request.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	val     []byte
+	err     error
+}
+
+// do runs fn once for all concurrent callers with the same key and reports
+// whether the caller joined a download that was already in flight.
+//
+// fn runs with a context detached from any single caller, so one caller
+// giving up does not fail the others. The download is cancelled only once
+// every caller waiting for it has gone.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) ([]byte, error)) ([]byte, bool, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*flight{}
+	}
+	f, shared := g.calls[key]
+	if !shared {
+		flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = f
+		go func() {
+			f.val, f.err = fn(flightCtx)
+			cancel()
+			g.mu.Lock()
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+			close(f.done)
+		}()
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.val, shared, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			f.cancel()
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, shared, ctx.Err()
+	}
+}
@@ -0,0 +1,124 @@
+package pokeclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+// slowServer holds every request until release is closed.
+func slowServer(release <-chan struct{}) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "pikachu", "base_experience": 112}`))
+	}))
+	return server, &calls
+}
+
+func TestConcurrentRequestsAreCoalesced(t *testing.T) {
+	release := make(chan struct{})
+	server, calls := slowServer(release)
+	defer server.Close()
+
+	cache := pokecache.NewCache(5*time.Second, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+	const callers = 10
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Go(func() {
+			result, err := client.GetPokemon(context.Background(), "pikachu")
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			if result.Name != "pikachu" {
+				t.Errorf("Expected name 'pikachu', got %s", result.Name)
+			}
+		})
+	}
+	// Give every caller time to join the request before it completes.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 server call, got %d", calls.Load())
+	}
+	if dedup := client.Stats().Deduplicated; dedup != callers-1 {
+		t.Errorf("Expected %d deduplicated calls, got %d", callers-1, dedup)
+	}
+}
+
+func TestCoalescedRequestSurvivesCancelledCaller(t *testing.T) {
+	release := make(chan struct{})
+	server, calls := slowServer(release)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstDone := make(chan error)
+	go func() {
+		_, err := client.GetPokemon(firstCtx, "pikachu")
+		firstDone <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	secondDone := make(chan error)
+	go func() {
+		_, err := client.GetPokemon(context.Background(), "pikachu")
+		secondDone <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancelFirst()
+	if err := <-firstDone; err == nil {
+		t.Error("Expected cancelled caller to get an error")
+	}
+	close(release)
+	if err := <-secondDone; err != nil {
+		t.Errorf("Expected remaining caller to get the response, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 server call, got %d", calls.Load())
+	}
+}
+
+func TestDistinctRequestsAreNotCoalesced(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	server, calls := slowServer(release)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"pikachu", "raichu", "pichu"} {
+		wg.Go(func() {
+			if _, err := client.GetPokemon(context.Background(), name); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 server calls, got %d", calls.Load())
+	}
+	if dedup := client.Stats().Deduplicated; dedup != 0 {
+		t.Errorf("Expected no deduplicated calls, got %d", dedup)
+	}
+}
@@ -0,0 +1,78 @@
+package pokeclient
+
+import (
+	"context"
+	"slices"
+)
+
+// Encounter is one encounter slot, such as walking in tall grass during the
+// morning.
+type Encounter struct {
+	MinLevel        int     `json:"min_level"`
+	MaxLevel        int     `json:"max_level"`
+	ConditionValues []Entry `json:"condition_values"`
+	// Chance is the percent chance of this slot being picked.
+	Chance int   `json:"chance"`
+	Method Entry `json:"method"`
+}
+
+// VersionEncounterDetail lists the encounter slots of a pokemon in one game
+// version.
+type VersionEncounterDetail struct {
+	Version          Entry       `json:"version"`
+	MaxChance        int         `json:"max_chance"`
+	EncounterDetails []Encounter `json:"encounter_details"`
+}
+
+// EncounterSummary combines the encounter slots that share a method and
+// conditions.
+type EncounterSummary struct {
+	Method     string
+	Conditions []string
+	MinLevel   int
+	MaxLevel   int
+	// Chance is the sum of the chances of the combined slots.
+	Chance int
+}
+
+// SummarizeEncounters combines encounter slots that share a method and
+// conditions, keeping the order in which each combination first appears.
+func SummarizeEncounters(encounters []Encounter) []EncounterSummary {
+	var summaries []EncounterSummary
+	for _, encounter := range encounters {
+		conditions := make([]string, len(encounter.ConditionValues))
+		for i, condition := range encounter.ConditionValues {
+			conditions[i] = condition.Name
+		}
+		i := slices.IndexFunc(summaries, func(s EncounterSummary) bool {
+			return s.Method == encounter.Method.Name && slices.Equal(s.Conditions, conditions)
+		})
+		if i < 0 {
+			summaries = append(summaries, EncounterSummary{
+				Method:     encounter.Method.Name,
+				Conditions: conditions,
+				MinLevel:   encounter.MinLevel,
+				MaxLevel:   encounter.MaxLevel,
+			})
+			i = len(summaries) - 1
+		}
+		summary := &summaries[i]
+		summary.MinLevel = min(summary.MinLevel, encounter.MinLevel)
+		summary.MaxLevel = max(summary.MaxLevel, encounter.MaxLevel)
+		summary.Chance += encounter.Chance
+	}
+	return summaries
+}
+
+// LocationAreaEncounter lists how a pokemon is encountered in one location
+// area.
+type LocationAreaEncounter struct {
+	LocationArea   Entry                    `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// GetPokemonEncounters returns every location area a pokemon can be
+// encountered in.
+func (c *Client) GetPokemonEncounters(ctx context.Context, name string) ([]LocationAreaEncounter, error) {
+	return fetchResource[[]LocationAreaEncounter](ctx, c, ErrPokemonNotFound, "pokemon", name, "encounters")
+}
@@ -0,0 +1,253 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const viridianForestArea = `{
+	"name": "viridian-forest-area",
+	"pokemon_encounters": [
+		{"pokemon": {"name": "pikachu"}, "version_details": [
+			{"version": {"name": "red"}, "max_chance": 5, "encounter_details": [
+				{"min_level": 3, "max_level": 3, "chance": 5, "method": {"name": "walk"}, "condition_values": []}
+			]}
+		]}
+	]
+}`
+
+func TestGetLocationAreaEncounters(t *testing.T) {
+	tests := []struct {
+		name              string
+		areaName          string
+		serverResponse    string
+		serverStatus      int
+		expectError       bool
+		expectNotFoundErr bool
+		expectedPokemon   []string
+		expectedVersion   string
+		expectedMethod    string
+	}{
+		{
+			name:            "successful response",
+			areaName:        "viridian-forest-area",
+			serverResponse:  viridianForestArea,
+			serverStatus:    http.StatusOK,
+			expectedPokemon: []string{"pikachu"},
+			expectedVersion: "red",
+			expectedMethod:  "walk",
+		},
+		{
+			name:           "area without encounters",
+			areaName:       "pallet-town-area",
+			serverResponse: `{"name": "pallet-town-area", "pokemon_encounters": []}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			name:              "area not found - 404",
+			areaName:          "nowhere",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			areaName:     "viridian-forest-area",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			areaName:       "viridian-forest-area",
+			serverResponse: `{"name": "viridian-forest-area", "pokemon_encounters": [{"version_details": [{"encounter_details": [{"min_level": "three"}]}]}]}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			areaName:       "viridian-forest-area",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			encounters, err := client.GetLocationAreaEncounters(context.Background(), tt.areaName)
+
+			if requestedPath != "/location-area/"+tt.areaName {
+				t.Errorf("Expected request path /location-area/%s, got %s", tt.areaName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrLocationAreaNotFound) {
+				t.Errorf("Expected ErrLocationAreaNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				var pokemon []string
+				for _, encounter := range encounters {
+					pokemon = append(pokemon, encounter.Pokemon.Name)
+				}
+				if !slices.Equal(pokemon, tt.expectedPokemon) {
+					t.Fatalf("Expected pokemon %v, got %v", tt.expectedPokemon, pokemon)
+				}
+				if len(encounters) > 0 {
+					details := encounters[0].VersionDetails[0]
+					if details.Version.Name != tt.expectedVersion || details.EncounterDetails[0].Method.Name != tt.expectedMethod {
+						t.Errorf("Expected %s encounters in %s, got %+v", tt.expectedMethod, tt.expectedVersion, details)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizeEncounters(t *testing.T) {
+	walk := pokeclient.Entry{Name: "walk"}
+	morning := []pokeclient.Entry{{Name: "time-morning"}}
+	encounters := []pokeclient.Encounter{
+		{MinLevel: 3, MaxLevel: 3, Chance: 20, Method: walk},
+		{MinLevel: 5, MaxLevel: 6, Chance: 10, Method: walk},
+		{MinLevel: 10, MaxLevel: 15, Chance: 60, Method: pokeclient.Entry{Name: "surf"}},
+		{MinLevel: 2, MaxLevel: 4, Chance: 15, Method: walk, ConditionValues: morning},
+		{MinLevel: 4, MaxLevel: 4, Chance: 5, Method: walk},
+	}
+
+	summaries := pokeclient.SummarizeEncounters(encounters)
+	if len(summaries) != 3 {
+		t.Fatalf("Expected 3 summaries, got %+v", summaries)
+	}
+	expected := []pokeclient.EncounterSummary{
+		{Method: "walk", Conditions: []string{}, MinLevel: 3, MaxLevel: 6, Chance: 35},
+		{Method: "surf", Conditions: []string{}, MinLevel: 10, MaxLevel: 15, Chance: 60},
+		{Method: "walk", Conditions: []string{"time-morning"}, MinLevel: 2, MaxLevel: 4, Chance: 15},
+	}
+	for i, summary := range summaries {
+		e := expected[i]
+		if summary.Method != e.Method || !slices.Equal(summary.Conditions, e.Conditions) ||
+			summary.MinLevel != e.MinLevel || summary.MaxLevel != e.MaxLevel || summary.Chance != e.Chance {
+			t.Errorf("Summary %d: expected %+v, got %+v", i, e, summary)
+		}
+	}
+}
+
+func TestGetPokemonEncounters(t *testing.T) {
+	tests := []struct {
+		name              string
+		pokemonName       string
+		serverResponse    string
+		serverStatus      int
+		expectError       bool
+		expectNotFoundErr bool
+		expectedAreas     []string
+		expectedMaxChance int
+	}{
+		{
+			name:        "successful response",
+			pokemonName: "pikachu",
+			serverResponse: `[
+				{"location_area": {"name": "viridian-forest-area"}, "version_details": [
+					{"version": {"name": "red"}, "max_chance": 5, "encounter_details": [{"min_level": 3, "max_level": 3, "chance": 5, "method": {"name": "walk"}}]}
+				]}
+			]`,
+			serverStatus:      http.StatusOK,
+			expectedAreas:     []string{"viridian-forest-area"},
+			expectedMaxChance: 5,
+		},
+		{
+			name:           "pokemon not found in the wild",
+			pokemonName:    "mew",
+			serverResponse: `[]`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			name:              "pokemon not found - 404",
+			pokemonName:       "missingno",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			pokemonName:  "pikachu",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			pokemonName:    "pikachu",
+			serverResponse: `{"location_area": {"name": "viridian-forest-area"}}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			pokemonName:    "pikachu",
+			serverResponse: `[invalid json]`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			encounters, err := client.GetPokemonEncounters(context.Background(), tt.pokemonName)
+
+			if requestedPath != "/pokemon/"+tt.pokemonName+"/encounters" {
+				t.Errorf("Expected request path /pokemon/%s/encounters, got %s", tt.pokemonName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrPokemonNotFound) {
+				t.Errorf("Expected ErrPokemonNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				var areas []string
+				for _, encounter := range encounters {
+					areas = append(areas, encounter.LocationArea.Name)
+				}
+				if !slices.Equal(areas, tt.expectedAreas) {
+					t.Fatalf("Expected areas %v, got %v", tt.expectedAreas, areas)
+				}
+				if len(encounters) > 0 && encounters[0].VersionDetails[0].MaxChance != tt.expectedMaxChance {
+					t.Errorf("Expected max chance %d, got %d", tt.expectedMaxChance, encounters[0].VersionDetails[0].MaxChance)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package pokeclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrPokemonNotFound        = errors.New("pokemon not found")
+	ErrLocationAreaNotFound   = errors.New("location area not found")
+	ErrSpeciesNotFound        = errors.New("pokemon species not found")
+	ErrMoveNotFound           = errors.New("move not found")
+	ErrAbilityNotFound        = errors.New("ability not found")
+	ErrTypeNotFound           = errors.New("type not found")
+	ErrItemNotFound           = errors.New("item not found")
+	ErrItemCategoryNotFound   = errors.New("item category not found")
+	ErrRegionNotFound         = errors.New("region not found")
+	ErrLocationNotFound       = errors.New("location not found")
+	ErrBerryNotFound          = errors.New("berry not found")
+	ErrBerryFlavorNotFound    = errors.New("berry flavor not found")
+	ErrGenerationNotFound     = errors.New("generation not found")
+	ErrVersionNotFound        = errors.New("version not found")
+	ErrVersionGroupNotFound   = errors.New("version group not found")
+	ErrNatureNotFound         = errors.New("nature not found")
+	ErrGrowthRateNotFound     = errors.New("growth rate not found")
+	ErrCharacteristicNotFound = errors.New("characteristic not found")
+	ErrLanguageNotFound       = errors.New("language not found")
+)
+
+// maxErrorBodySnippet caps how much of an error response is kept in an
+// HTTPStatusError.
+const maxErrorBodySnippet = 256
+
+// HTTPStatusError is returned when PokeAPI answers with a status other than
+// 200 OK. Not found responses are additionally wrapped with the sentinel error
+// of the resource, such as ErrPokemonNotFound.
+type HTTPStatusError struct {
+	StatusCode int
+	URL        string
+	// Body holds the start of the response body, which often explains the
+	// failure.
+	Body string
+}
+
+func (e *HTTPStatusError) Error() string {
+	msg := fmt.Sprintf("GET %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// DecodeError is returned when a response body is not the JSON we expect.
+type DecodeError struct {
+	URL string
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("decoding response from %s: %v", e.URL, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// checkStatus returns an *HTTPStatusError for any response other than 200 OK,
+// wrapped with notFound when the resource does not exist.
+func checkStatus(res *http.Response, notFound error) error {
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	snippet, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySnippet))
+	err := &HTTPStatusError{
+		StatusCode: res.StatusCode,
+		URL:        res.Request.URL.String(),
+		Body:       strings.TrimSpace(string(snippet)),
+	}
+	if res.StatusCode == http.StatusNotFound && notFound != nil {
+		return fmt.Errorf("%w: %w", notFound, err)
+	}
+	return err
+}
@@ -0,0 +1,124 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestLocationAreaNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Not Found"))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	_, err := client.GetPokemonForLocationName(context.Background(), "nowhere")
+
+	if !errors.Is(err, pokeclient.ErrLocationAreaNotFound) {
+		t.Errorf("Expected ErrLocationAreaNotFound, got %v", err)
+	}
+	var statusErr *pokeclient.HTTPStatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("Expected *HTTPStatusError, got %T", err)
+	}
+	if statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", statusErr.StatusCode)
+	}
+	if !strings.HasSuffix(statusErr.URL, "/location-area/nowhere") {
+		t.Errorf("Expected URL to name the location area, got %s", statusErr.URL)
+	}
+}
+
+func TestHTTPStatusErrorBodySnippet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("database unavailable" + strings.Repeat(".", 1000)))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient()
+	_, err := client.GetLocationAreas(context.Background(), server.URL)
+
+	var statusErr *pokeclient.HTTPStatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("Expected *HTTPStatusError, got %v", err)
+	}
+	if statusErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", statusErr.StatusCode)
+	}
+	if !strings.HasPrefix(statusErr.Body, "database unavailable") {
+		t.Errorf("Expected body snippet, got %q", statusErr.Body)
+	}
+	if len(statusErr.Body) > 256 {
+		t.Errorf("Expected body snippet to be truncated, got %d bytes", len(statusErr.Body))
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<html>maintenance</html>`))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	_, err := client.GetPokemon(context.Background(), "snorlax")
+
+	var decodeErr *pokeclient.DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected *DecodeError, got %v", err)
+	}
+	if !strings.HasSuffix(decodeErr.URL, "/pokemon/snorlax") {
+		t.Errorf("Expected URL to name the pokemon, got %s", decodeErr.URL)
+	}
+}
+
+func TestOnlySuccessfulResponsesAreCached(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "not found", status: http.StatusNotFound, body: "Not Found"},
+		{name: "server error", status: http.StatusInternalServerError, body: `{"name": "snorlax"}`},
+		{name: "malformed body", status: http.StatusOK, body: `{invalid json}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				callCount++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			cache := pokecache.NewCache(5*time.Second, context.Background())
+			defer cache.Close()
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+			for range 2 {
+				if _, err := client.GetPokemon(context.Background(), "snorlax"); err == nil {
+					t.Error("Expected error but got none")
+				}
+			}
+			if callCount != 2 {
+				t.Errorf("Expected failed response not to be cached (2 server calls), got %d", callCount)
+			}
+		})
+	}
+}
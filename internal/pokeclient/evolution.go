@@ -0,0 +1,150 @@
+package pokeclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrNoEvolutionChain = errors.New("species has no evolution chain")
+
+// APIResource links to a resource that has no name, such as an evolution
+// chain.
+type APIResource struct {
+	Url string `json:"url"`
+}
+
+// EvolutionDetail describes one way of evolving into a species. Only the
+// conditions that apply are set.
+type EvolutionDetail struct {
+	Trigger               Entry  `json:"trigger"`
+	MinLevel              *int   `json:"min_level"`
+	Item                  *Entry `json:"item"`
+	HeldItem              *Entry `json:"held_item"`
+	KnownMove             *Entry `json:"known_move"`
+	KnownMoveType         *Entry `json:"known_move_type"`
+	Location              *Entry `json:"location"`
+	MinHappiness          *int   `json:"min_happiness"`
+	MinAffection          *int   `json:"min_affection"`
+	MinBeauty             *int   `json:"min_beauty"`
+	TimeOfDay             string `json:"time_of_day"`
+	Gender                *int   `json:"gender"`
+	NeedsOverworldRain    bool   `json:"needs_overworld_rain"`
+	PartySpecies          *Entry `json:"party_species"`
+	PartyType             *Entry `json:"party_type"`
+	RelativePhysicalStats *int   `json:"relative_physical_stats"`
+	TradeSpecies          *Entry `json:"trade_species"`
+	TurnUpsideDown        bool   `json:"turn_upside_down"`
+}
+
+// String describes the detail the way a player would, such as "level 16" or
+// "use thunder-stone".
+func (d EvolutionDetail) String() string {
+	var parts []string
+	switch d.Trigger.Name {
+	case "level-up":
+		if d.MinLevel != nil {
+			parts = append(parts, fmt.Sprintf("level %d", *d.MinLevel))
+		} else {
+			parts = append(parts, "level up")
+		}
+	case "use-item":
+		if d.Item != nil {
+			parts = append(parts, "use "+d.Item.Name)
+		} else {
+			parts = append(parts, "use item")
+		}
+	default:
+		parts = append(parts, strings.ReplaceAll(d.Trigger.Name, "-", " "))
+		if d.MinLevel != nil {
+			parts = append(parts, fmt.Sprintf("from level %d", *d.MinLevel))
+		}
+	}
+
+	if d.TradeSpecies != nil {
+		parts = append(parts, "for "+d.TradeSpecies.Name)
+	}
+	if d.HeldItem != nil {
+		parts = append(parts, "holding "+d.HeldItem.Name)
+	}
+	if d.KnownMove != nil {
+		parts = append(parts, "knowing "+d.KnownMove.Name)
+	}
+	if d.KnownMoveType != nil {
+		parts = append(parts, "knowing a "+d.KnownMoveType.Name+" move")
+	}
+	if d.MinHappiness != nil {
+		parts = append(parts, fmt.Sprintf("with friendship %d+", *d.MinHappiness))
+	}
+	if d.MinAffection != nil {
+		parts = append(parts, fmt.Sprintf("with affection %d+", *d.MinAffection))
+	}
+	if d.MinBeauty != nil {
+		parts = append(parts, fmt.Sprintf("with beauty %d+", *d.MinBeauty))
+	}
+	if d.Location != nil {
+		parts = append(parts, "at "+d.Location.Name)
+	}
+	if d.TimeOfDay != "" {
+		parts = append(parts, "during "+d.TimeOfDay)
+	}
+	if d.Gender != nil {
+		switch *d.Gender {
+		case 1:
+			parts = append(parts, "if female")
+		case 2:
+			parts = append(parts, "if male")
+		}
+	}
+	if d.NeedsOverworldRain {
+		parts = append(parts, "in rain")
+	}
+	if d.PartySpecies != nil {
+		parts = append(parts, "with "+d.PartySpecies.Name+" in party")
+	}
+	if d.PartyType != nil {
+		parts = append(parts, "with a "+d.PartyType.Name+" type in party")
+	}
+	if d.RelativePhysicalStats != nil {
+		switch *d.RelativePhysicalStats {
+		case 1:
+			parts = append(parts, "if attack > defense")
+		case 0:
+			parts = append(parts, "if attack = defense")
+		case -1:
+			parts = append(parts, "if attack < defense")
+		}
+	}
+	if d.TurnUpsideDown {
+		parts = append(parts, "holding the console upside down")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ChainLink is a species in an evolution chain and the species it evolves
+// into.
+type ChainLink struct {
+	Species          Entry             `json:"species"`
+	IsBaby           bool              `json:"is_baby"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+type EvolutionChain struct {
+	ID    int       `json:"id"`
+	Chain ChainLink `json:"chain"`
+}
+
+// GetEvolutionChain returns the evolution chain the named species belongs
+// to.
+func (c *Client) GetEvolutionChain(ctx context.Context, speciesName string) (EvolutionChain, error) {
+	species, err := c.GetPokemonSpecies(ctx, speciesName)
+	if err != nil {
+		return EvolutionChain{}, err
+	}
+	if species.EvolutionChain == nil || species.EvolutionChain.Url == "" {
+		return EvolutionChain{}, fmt.Errorf("%w: %s", ErrNoEvolutionChain, speciesName)
+	}
+	return Fetch[EvolutionChain](ctx, c, species.EvolutionChain.Url, nil)
+}
@@ -0,0 +1,209 @@
+package pokeclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const eeveeChain = `{
+	"id": 67,
+	"chain": {
+		"species": {"name": "eevee"},
+		"is_baby": false,
+		"evolution_details": [],
+		"evolves_to": [
+			{
+				"species": {"name": "vaporeon"},
+				"evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "water-stone"}}],
+				"evolves_to": []
+			},
+			{
+				"species": {"name": "espeon"},
+				"evolution_details": [{"trigger": {"name": "level-up"}, "min_happiness": 160, "time_of_day": "day"}],
+				"evolves_to": []
+			}
+		]
+	}
+}`
+
+func TestGetEvolutionChain(t *testing.T) {
+	// {server} is replaced with the test server URL.
+	const eeveeSpecies = `{"name": "eevee", "evolution_chain": {"url": "{server}/evolution-chain/67/"}}`
+	tests := []struct {
+		name            string
+		speciesStatus   int
+		speciesResponse string
+		chainStatus     int
+		chainResponse   string
+		expectError     bool
+		expectedErr     error
+		expectedID      int
+	}{
+		{
+			name:            "successful response",
+			speciesStatus:   http.StatusOK,
+			speciesResponse: eeveeSpecies,
+			chainStatus:     http.StatusOK,
+			chainResponse:   eeveeChain,
+			expectedID:      67,
+		},
+		{
+			name:            "species without a chain",
+			speciesStatus:   http.StatusOK,
+			speciesResponse: `{"name": "eevee", "evolution_chain": null}`,
+			expectError:     true,
+			expectedErr:     pokeclient.ErrNoEvolutionChain,
+		},
+		{
+			name:          "species not found - 404",
+			speciesStatus: http.StatusNotFound,
+			expectError:   true,
+			expectedErr:   pokeclient.ErrSpeciesNotFound,
+		},
+		{
+			name:            "chain not found - 404",
+			speciesStatus:   http.StatusOK,
+			speciesResponse: eeveeSpecies,
+			chainStatus:     http.StatusNotFound,
+			expectError:     true,
+		},
+		{
+			name:            "server error",
+			speciesStatus:   http.StatusOK,
+			speciesResponse: eeveeSpecies,
+			chainStatus:     http.StatusInternalServerError,
+			expectError:     true,
+		},
+		{
+			name:            "invalid json",
+			speciesStatus:   http.StatusOK,
+			speciesResponse: eeveeSpecies,
+			chainStatus:     http.StatusOK,
+			chainResponse:   `{"id": "sixty-seven"}`,
+			expectError:     true,
+		},
+		{
+			name:            "malformed json",
+			speciesStatus:   http.StatusOK,
+			speciesResponse: eeveeSpecies,
+			chainStatus:     http.StatusOK,
+			chainResponse:   `{invalid json}`,
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/pokemon-species/eevee":
+					w.WriteHeader(tt.speciesStatus)
+					w.Write([]byte(strings.ReplaceAll(tt.speciesResponse, "{server}", server.URL)))
+				case "/evolution-chain/67/":
+					w.WriteHeader(tt.chainStatus)
+					w.Write([]byte(tt.chainResponse))
+				default:
+					t.Errorf("Unexpected request path %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			chain, err := client.GetEvolutionChain(context.Background(), "eevee")
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Expected %v, got %v", tt.expectedErr, err)
+			}
+			if !tt.expectError && chain.ID != tt.expectedID {
+				t.Errorf("Expected chain %d, got %d", tt.expectedID, chain.ID)
+			}
+		})
+	}
+}
+
+func TestEvolutionChainLinks(t *testing.T) {
+	var chain pokeclient.EvolutionChain
+	if err := json.Unmarshal([]byte(eeveeChain), &chain); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if chain.Chain.Species.Name != "eevee" {
+		t.Errorf("Expected chain to start at eevee, got %s", chain.Chain.Species.Name)
+	}
+	if len(chain.Chain.EvolvesTo) != 2 {
+		t.Fatalf("Expected 2 evolutions, got %d", len(chain.Chain.EvolvesTo))
+	}
+	espeon := chain.Chain.EvolvesTo[1]
+	if espeon.Species.Name != "espeon" {
+		t.Errorf("Expected espeon, got %s", espeon.Species.Name)
+	}
+	if len(espeon.EvolutionDetails) != 1 || espeon.EvolutionDetails[0].TimeOfDay != "day" {
+		t.Errorf("Expected espeon to evolve during the day, got %+v", espeon.EvolutionDetails)
+	}
+}
+
+func TestEvolutionDetailString(t *testing.T) {
+	level := 16
+	happiness := 220
+	female := 1
+	tests := []struct {
+		name     string
+		detail   pokeclient.EvolutionDetail
+		expected string
+	}{
+		{
+			name:     "level",
+			detail:   pokeclient.EvolutionDetail{Trigger: pokeclient.Entry{Name: "level-up"}, MinLevel: &level},
+			expected: "level 16",
+		},
+		{
+			name:     "item",
+			detail:   pokeclient.EvolutionDetail{Trigger: pokeclient.Entry{Name: "use-item"}, Item: &pokeclient.Entry{Name: "thunder-stone"}},
+			expected: "use thunder-stone",
+		},
+		{
+			name:     "trade with held item",
+			detail:   pokeclient.EvolutionDetail{Trigger: pokeclient.Entry{Name: "trade"}, HeldItem: &pokeclient.Entry{Name: "metal-coat"}},
+			expected: "trade, holding metal-coat",
+		},
+		{
+			name:     "friendship at night",
+			detail:   pokeclient.EvolutionDetail{Trigger: pokeclient.Entry{Name: "level-up"}, MinHappiness: &happiness, TimeOfDay: "night"},
+			expected: "level up, with friendship 220+, during night",
+		},
+		{
+			name:     "gendered level",
+			detail:   pokeclient.EvolutionDetail{Trigger: pokeclient.Entry{Name: "level-up"}, MinLevel: &level, Gender: &female},
+			expected: "level 16, if female",
+		},
+		{
+			name:     "other trigger",
+			detail:   pokeclient.EvolutionDetail{Trigger: pokeclient.Entry{Name: "three-critical-hits"}},
+			expected: "three critical hits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.detail.String(); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package pokeclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+)
+
+// DefaultMaxResponseSize is large enough for the biggest PokeAPI resources,
+// such as pokemon with long move lists.
+const DefaultMaxResponseSize = 8 << 20
+
+var (
+	ErrResponseTooLarge      = errors.New("response too large")
+	ErrUnexpectedContentType = errors.New("unexpected content type")
+)
+
+// WithMaxResponseSize limits how many bytes of a response body are read.
+// Larger responses fail with ErrResponseTooLarge.
+func WithMaxResponseSize(n int64) Option {
+	return func(c *Client) {
+		c.maxResponseSize = n
+	}
+}
+
+// Fetch GETs url from the client's PokeAPI and decodes the JSON body into a T.
+// Responses are served from the client's cache when possible, and only
+// well-formed JSON bodies are added to it. Expired entries that carry an
+// ETag or Last-Modified validator are revalidated with a conditional request
+// instead of being downloaded again. A 404 response is reported with notFound
+// wrapped around the *HTTPStatusError, when notFound is not nil. Concurrent
+// calls for the same url share a single request.
+func Fetch[T any](ctx context.Context, c *Client, url string, notFound error) (T, error) {
+	var out T
+	d, err := c.fetchBody(ctx, url, notFound, jsonFormat, validJSON)
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(d, &out); err != nil {
+		return out, &DecodeError{URL: url, Err: err}
+	}
+	return out, nil
+}
+
+// fetchResource fetches the resource at the endpoint made of parts like
+// Fetch. Parts that are empty or a dot segment would name another resource
+// once the path is normalized, so they are reported with notFound without a
+// request.
+func fetchResource[T any](ctx context.Context, c *Client, notFound error, parts ...string) (T, error) {
+	for _, part := range parts {
+		if part == "" || part == "." || part == ".." {
+			var out T
+			return out, fmt.Errorf("%w: invalid name %q", notFound, part)
+		}
+	}
+	return Fetch[T](ctx, c, c.endpoint(parts...), notFound)
+}
+
+// fetchBody returns the body of url like Fetch, for any format. Only bodies
+// that pass validate are cached.
+func (c *Client) fetchBody(ctx context.Context, url string, notFound error, format responseFormat, validate func([]byte) error) ([]byte, error) {
+	if d, ok := c.cache.Get(url); ok {
+		return d, nil
+	}
+
+	d, shared, err := c.flights.do(ctx, url, func(ctx context.Context) ([]byte, error) {
+		stale, validators, _ := c.cache.GetStale(url)
+		res, err := c.download(ctx, url, notFound, format, validators)
+		if err != nil {
+			return nil, err
+		}
+		if res.notModified {
+			// Add the entry again in case it was reaped while revalidating.
+			c.cache.AddWithValidators(url, stale, res.validators)
+			return stale, nil
+		}
+		if err := validate(res.body); err != nil {
+			return nil, &DecodeError{URL: url, Err: err}
+		}
+		c.cache.AddWithValidators(url, res.body, res.validators)
+		return res.body, nil
+	})
+	if shared {
+		c.stats.deduplicated.Add(1)
+	}
+	return d, err
+}
+
+// responseFormat is a kind of response body the client asks for.
+type responseFormat struct {
+	// accept is sent as the Accept header.
+	accept string
+	// matches reports whether a response media type is in the format.
+	matches func(mediaType string) bool
+}
+
+var jsonFormat = responseFormat{accept: "application/json", matches: isJSON}
+
+// validJSON checks a body is well-formed without decoding it, which is much
+// cheaper than decoding large resources such as pokemon twice.
+func validJSON(body []byte) error {
+	if !json.Valid(body) {
+		return errors.New("invalid JSON")
+	}
+	return nil
+}
+
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+type downloaded struct {
+	body       []byte
+	validators pokecache.Validators
+	// notModified is set when the server confirmed the cached copy matching
+	// the validators passed to download is still current. body is empty then,
+	// and validators has any new validators the server sent.
+	notModified bool
+}
+
+// download GETs url and returns the body of a successful response in format.
+// Non-empty validators make the request conditional.
+func (c *Client) download(ctx context.Context, url string, notFound error, format responseFormat, validators pokecache.Validators) (downloaded, error) {
+	header := http.Header{}
+	header.Set("Accept", format.accept)
+	if validators.ETag != "" {
+		header.Set("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		header.Set("If-Modified-Since", validators.LastModified)
+	}
+
+	res, err := c.get(ctx, url, header)
+	if err != nil {
+		return downloaded{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotModified && !validators.Empty() {
+		c.stats.revalidated.Add(1)
+		c.logger.DebugContext(ctx, "cached response revalidated", "url", url)
+		if etag := res.Header.Get("ETag"); etag != "" {
+			validators.ETag = etag
+		}
+		if lastModified := res.Header.Get("Last-Modified"); lastModified != "" {
+			validators.LastModified = lastModified
+		}
+		return downloaded{notModified: true, validators: validators}, nil
+	}
+	if err := checkStatus(res, notFound); err != nil {
+		return downloaded{}, err
+	}
+	if err := checkContentType(res, format); err != nil {
+		return downloaded{}, err
+	}
+
+	d, err := io.ReadAll(io.LimitReader(res.Body, c.maxResponseSize+1))
+	if err != nil {
+		return downloaded{}, err
+	}
+	if int64(len(d)) > c.maxResponseSize {
+		return downloaded{}, fmt.Errorf("GET %s: %w: more than %d bytes", url, ErrResponseTooLarge, c.maxResponseSize)
+	}
+	return downloaded{
+		body: d,
+		validators: pokecache.Validators{
+			ETag:         res.Header.Get("ETag"),
+			LastModified: res.Header.Get("Last-Modified"),
+		},
+	}, nil
+}
+
+func checkContentType(res *http.Response, format responseFormat) error {
+	contentType := res.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !format.matches(mediaType) {
+		return fmt.Errorf("GET %s: %w %q", res.Request.URL, ErrUnexpectedContentType, contentType)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+type testResource struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func newResourceServer(contentType, body string, callCount *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*callCount++
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+}
+
+func TestFetch(t *testing.T) {
+	callCount := 0
+	server := newResourceServer("application/json; charset=utf-8", `{"id": 7, "name": "squirtle"}`, &callCount)
+	defer server.Close()
+
+	cache := pokecache.NewCache(5*time.Second, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+	for range 2 {
+		result, err := pokeclient.Fetch[testResource](context.Background(), client, server.URL+"/pokemon/7", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.ID != 7 || result.Name != "squirtle" {
+			t.Errorf("Expected squirtle (7), got %s (%d)", result.Name, result.ID)
+		}
+	}
+	if callCount != 1 {
+		t.Errorf("Expected cache to be used (1 server call), got %d calls", callCount)
+	}
+}
+
+func TestFetchContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		expectError bool
+	}{
+		{name: "json", contentType: "application/json", expectError: false},
+		{name: "json with charset", contentType: "application/json; charset=utf-8", expectError: false},
+		{name: "json suffix", contentType: "application/problem+json", expectError: false},
+		{name: "html", contentType: "text/html; charset=utf-8", expectError: true},
+		{name: "missing", contentType: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callCount := 0
+			server := newResourceServer(tt.contentType, `{"id": 1, "name": "bulbasaur"}`, &callCount)
+			defer server.Close()
+
+			client := pokeclient.NewClient()
+			_, err := pokeclient.Fetch[testResource](context.Background(), client, server.URL, nil)
+
+			if tt.expectError && !errors.Is(err, pokeclient.ErrUnexpectedContentType) {
+				t.Errorf("Expected ErrUnexpectedContentType, got %v", err)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFetchMaxResponseSize(t *testing.T) {
+	callCount := 0
+	body := `{"id": 1, "name": "` + strings.Repeat("a", 100) + `"}`
+	server := newResourceServer("application/json", body, &callCount)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithMaxResponseSize(64))
+	_, err := pokeclient.Fetch[testResource](context.Background(), client, server.URL, nil)
+	if !errors.Is(err, pokeclient.ErrResponseTooLarge) {
+		t.Errorf("Expected ErrResponseTooLarge, got %v", err)
+	}
+
+	client = pokeclient.NewClient(pokeclient.WithMaxResponseSize(int64(len(body))))
+	if _, err := pokeclient.Fetch[testResource](context.Background(), client, server.URL, nil); err != nil {
+		t.Errorf("Expected response at the limit to succeed, got %v", err)
+	}
+}
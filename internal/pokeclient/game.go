@@ -0,0 +1,42 @@
+package pokeclient
+
+import "context"
+
+// Generation is a group of games released together, such as generation-i
+// for Red, Blue and Yellow.
+type Generation struct {
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	MainRegion    Entry   `json:"main_region"`
+	VersionGroups []Entry `json:"version_groups"`
+}
+
+// Version is a single game, such as red.
+type Version struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	VersionGroup Entry  `json:"version_group"`
+}
+
+// VersionGroup is a set of games that share most of their data, such as
+// red-blue. Learnsets are recorded per version group.
+type VersionGroup struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Order      int     `json:"order"`
+	Generation Entry   `json:"generation"`
+	Regions    []Entry `json:"regions"`
+	Versions   []Entry `json:"versions"`
+}
+
+func (c *Client) GetGeneration(ctx context.Context, name string) (Generation, error) {
+	return fetchResource[Generation](ctx, c, ErrGenerationNotFound, "generation", name)
+}
+
+func (c *Client) GetVersion(ctx context.Context, name string) (Version, error) {
+	return fetchResource[Version](ctx, c, ErrVersionNotFound, "version", name)
+}
+
+func (c *Client) GetVersionGroup(ctx context.Context, name string) (VersionGroup, error) {
+	return fetchResource[VersionGroup](ctx, c, ErrVersionGroupNotFound, "version-group", name)
+}
@@ -0,0 +1,273 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestGetGeneration(t *testing.T) {
+	tests := []struct {
+		name                  string
+		generationName        string
+		serverResponse        string
+		serverStatus          int
+		expectError           bool
+		expectNotFoundErr     bool
+		expectedMainRegion    string
+		expectedVersionGroups []string
+	}{
+		{
+			name:                  "successful response",
+			generationName:        "generation-i",
+			serverResponse:        `{"id": 1, "name": "generation-i", "main_region": {"name": "kanto"}, "version_groups": [{"name": "red-blue"}, {"name": "yellow"}]}`,
+			serverStatus:          http.StatusOK,
+			expectedMainRegion:    "kanto",
+			expectedVersionGroups: []string{"red-blue", "yellow"},
+		},
+		{
+			name:              "generation not found - 404",
+			generationName:    "generation-x",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:           "server error",
+			generationName: "generation-i",
+			serverStatus:   http.StatusInternalServerError,
+			expectError:    true,
+		},
+		{
+			name:           "invalid json",
+			generationName: "generation-i",
+			serverResponse: `{"name": "generation-i", "version_groups": {"name": "red-blue"}}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			generationName: "generation-i",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			generation, err := client.GetGeneration(context.Background(), tt.generationName)
+
+			if requestedPath != "/generation/"+tt.generationName {
+				t.Errorf("Expected request path /generation/%s, got %s", tt.generationName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrGenerationNotFound) {
+				t.Errorf("Expected ErrGenerationNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if generation.MainRegion.Name != tt.expectedMainRegion {
+					t.Errorf("Expected main region %s, got %s", tt.expectedMainRegion, generation.MainRegion.Name)
+				}
+				var groups []string
+				for _, group := range generation.VersionGroups {
+					groups = append(groups, group.Name)
+				}
+				if !slices.Equal(groups, tt.expectedVersionGroups) {
+					t.Errorf("Expected version groups %v, got %v", tt.expectedVersionGroups, groups)
+				}
+			}
+		})
+	}
+}
+
+func TestGetVersion(t *testing.T) {
+	tests := []struct {
+		name                 string
+		versionName          string
+		serverResponse       string
+		serverStatus         int
+		expectError          bool
+		expectNotFoundErr    bool
+		expectedVersionGroup string
+	}{
+		{
+			name:                 "successful response",
+			versionName:          "red",
+			serverResponse:       `{"id": 1, "name": "red", "version_group": {"name": "red-blue"}}`,
+			serverStatus:         http.StatusOK,
+			expectedVersionGroup: "red-blue",
+		},
+		{
+			name:              "version not found - 404",
+			versionName:       "purple",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			versionName:  "red",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			versionName:    "red",
+			serverResponse: `{"name": "red", "version_group": "red-blue"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			versionName:    "red",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			version, err := client.GetVersion(context.Background(), tt.versionName)
+
+			if requestedPath != "/version/"+tt.versionName {
+				t.Errorf("Expected request path /version/%s, got %s", tt.versionName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrVersionNotFound) {
+				t.Errorf("Expected ErrVersionNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if version.VersionGroup.Name != tt.expectedVersionGroup {
+					t.Errorf("Expected %s to be in %s, got %s", tt.versionName, tt.expectedVersionGroup, version.VersionGroup.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestGetVersionGroup(t *testing.T) {
+	tests := []struct {
+		name               string
+		versionGroupName   string
+		serverResponse     string
+		serverStatus       int
+		expectError        bool
+		expectNotFoundErr  bool
+		expectedGeneration string
+		expectedVersions   []string
+	}{
+		{
+			name:               "successful response",
+			versionGroupName:   "red-blue",
+			serverResponse:     `{"id": 1, "name": "red-blue", "order": 1, "generation": {"name": "generation-i"}, "regions": [{"name": "kanto"}], "versions": [{"name": "red"}, {"name": "blue"}]}`,
+			serverStatus:       http.StatusOK,
+			expectedGeneration: "generation-i",
+			expectedVersions:   []string{"red", "blue"},
+		},
+		{
+			name:              "version group not found - 404",
+			versionGroupName:  "purple-orange",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:             "server error",
+			versionGroupName: "red-blue",
+			serverStatus:     http.StatusInternalServerError,
+			expectError:      true,
+		},
+		{
+			name:             "invalid json",
+			versionGroupName: "red-blue",
+			serverResponse:   `{"name": "red-blue", "order": "first"}`,
+			serverStatus:     http.StatusOK,
+			expectError:      true,
+		},
+		{
+			name:             "malformed json",
+			versionGroupName: "red-blue",
+			serverResponse:   `{invalid json}`,
+			serverStatus:     http.StatusOK,
+			expectError:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			group, err := client.GetVersionGroup(context.Background(), tt.versionGroupName)
+
+			if requestedPath != "/version-group/"+tt.versionGroupName {
+				t.Errorf("Expected request path /version-group/%s, got %s", tt.versionGroupName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrVersionGroupNotFound) {
+				t.Errorf("Expected ErrVersionGroupNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if group.Generation.Name != tt.expectedGeneration {
+					t.Errorf("Expected generation %s, got %s", tt.expectedGeneration, group.Generation.Name)
+				}
+				var versions []string
+				for _, version := range group.Versions {
+					versions = append(versions, version.Name)
+				}
+				if !slices.Equal(versions, tt.expectedVersions) {
+					t.Errorf("Expected versions %v, got %v", tt.expectedVersions, versions)
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,12 @@
 package pokeclient
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
-	"io"
-	"net/http"
-
-	"github.com/jabreu610/pokedexcli/internal/pokecache"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
 )
 
 type Entry struct {
@@ -14,6 +14,16 @@ type Entry struct {
 	Url  string `json:"url"`
 }
 
+// ID returns the numeric ID at the end of the entry's URL, or 0 if the URL
+// does not end in one.
+func (e Entry) ID() int {
+	id, err := strconv.Atoi(path.Base(strings.TrimRight(e.Url, "/")))
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
 type Stat struct {
 	Stat     Entry `json:"stat"`
 	BaseStat int   `json:"base_stat"`
@@ -24,42 +34,70 @@ type Type struct {
 }
 
 type Pokemon struct {
-	Name           string `json:"name"`
-	Height         int    `json:"height"`
-	Weight         int    `json:"weight"`
-	BaseExperience int    `json:"base_experience"`
-	Stats          []Stat `json:"stats"`
-	Types          []Type `json:"types"`
+	ID             int              `json:"id"`
+	Name           string           `json:"name"`
+	Height         int              `json:"height"`
+	Weight         int              `json:"weight"`
+	BaseExperience int              `json:"base_experience"`
+	Stats          []Stat           `json:"stats"`
+	Types          []Type           `json:"types"`
+	Species        Entry            `json:"species"`
+	Moves          []PokemonMove    `json:"moves"`
+	Abilities      []PokemonAbility `json:"abilities"`
+	Sprites        Sprites          `json:"sprites"`
 }
 
-var BaseUrlPokemon string = "https://pokeapi.co/api/v2/pokemon"
+// DexNumber returns the National Dex number of the pokemon, which is the ID
+// of its species.
+func (p Pokemon) DexNumber() int {
+	return p.Species.ID()
+}
 
-var ErrPokemonNotFound error = errors.New("pokemon not found")
+// GetPokemon fetches a pokemon by name or by resource ID.
+func (c *Client) GetPokemon(ctx context.Context, name string) (Pokemon, error) {
+	return fetchResource[Pokemon](ctx, c, ErrPokemonNotFound, "pokemon", name)
+}
 
-func GetPokemon(name string, cache *pokecache.Cache) (Pokemon, error) {
-	var p Pokemon
-	var d []byte
-	fullUrl := BaseUrlPokemon + "/" + name
-	d, ok := cache.Get(fullUrl)
+// ParseDexNumber parses a National Dex number written as "#25".
+func ParseDexNumber(key string) (int, bool) {
+	digits, ok := strings.CutPrefix(key, "#")
 	if !ok {
-		res, err := http.Get(fullUrl)
-		if err != nil {
-			return p, err
+		return 0, false
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// ResolvePokemonName returns the canonical name of the pokemon identified by
+// key. A National Dex number such as "#25" resolves to the default pokemon of
+// that species, and a number such as "25" or "10034" to the pokemon with that
+// resource ID. Any other key is returned as is, without a request. Unknown
+// numbers, and keys starting with "#" that are not dex numbers, are reported
+// as ErrPokemonNotFound.
+func (c *Client) ResolvePokemonName(ctx context.Context, key string) (string, error) {
+	if strings.HasPrefix(key, "#") {
+		n, ok := ParseDexNumber(key)
+		if !ok {
+			return "", fmt.Errorf("%w: %q is not a dex number", ErrPokemonNotFound, key)
 		}
-		if res.StatusCode == 404 {
-			return p, ErrPokemonNotFound
+		species, err := c.GetPokemonSpecies(ctx, strconv.Itoa(n))
+		if errors.Is(err, ErrSpeciesNotFound) {
+			return "", fmt.Errorf("%w: %w", ErrPokemonNotFound, err)
 		}
-		defer res.Body.Close()
-
-		d, err = io.ReadAll(res.Body)
 		if err != nil {
-			return p, err
+			return "", err
 		}
-		cache.Add(fullUrl, d)
+		return species.DefaultPokemon(), nil
 	}
-
-	if err := json.Unmarshal(d, &p); err != nil {
-		return p, err
+	if _, err := strconv.Atoi(key); err == nil {
+		pokemon, err := c.GetPokemon(ctx, key)
+		if err != nil {
+			return "", err
+		}
+		return pokemon.Name, nil
 	}
-	return p, nil
+	return key, nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
@@ -96,6 +97,7 @@ func TestGetPokemon(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(tt.serverStatus)
 				w.Write([]byte(tt.serverResponse))
 			}))
@@ -104,13 +106,9 @@ func TestGetPokemon(t *testing.T) {
 			cache := pokecache.NewCache(5*time.Second, context.Background())
 			defer cache.Close()
 
-			originalBaseURL := pokeclient.BaseUrlPokemon
-			pokeclient.BaseUrlPokemon = server.URL
-			defer func() {
-				pokeclient.BaseUrlPokemon = originalBaseURL
-			}()
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
 
-			result, err := pokeclient.GetPokemon(tt.pokemonName, cache)
+			result, err := client.GetPokemon(context.Background(), tt.pokemonName)
 
 			if tt.expectError && err == nil {
 				t.Error("Expected error but got none")
@@ -143,6 +141,7 @@ func TestGetPokemonCaching(t *testing.T) {
 			"name": "charizard",
 			"base_experience": 240
 		}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
@@ -151,14 +150,10 @@ func TestGetPokemonCaching(t *testing.T) {
 	cache := pokecache.NewCache(5*time.Second, context.Background())
 	defer cache.Close()
 
-	originalBaseURL := pokeclient.BaseUrlPokemon
-	pokeclient.BaseUrlPokemon = server.URL
-	defer func() {
-		pokeclient.BaseUrlPokemon = originalBaseURL
-	}()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
 
 	// First call - should hit the server
-	result1, err := pokeclient.GetPokemon("charizard", cache)
+	result1, err := client.GetPokemon(context.Background(), "charizard")
 	if err != nil {
 		t.Fatalf("First call failed: %v", err)
 	}
@@ -168,7 +163,7 @@ func TestGetPokemonCaching(t *testing.T) {
 	}
 
 	// Second call - should use cache
-	result2, err := pokeclient.GetPokemon("charizard", cache)
+	result2, err := client.GetPokemon(context.Background(), "charizard")
 	if err != nil {
 		t.Fatalf("Second call failed: %v", err)
 	}
@@ -192,19 +187,16 @@ func TestGetPokemonNilCache(t *testing.T) {
 			"name": "bulbasaur",
 			"base_experience": 64
 		}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
 	defer server.Close()
 
-	originalBaseURL := pokeclient.BaseUrlPokemon
-	pokeclient.BaseUrlPokemon = server.URL
-	defer func() {
-		pokeclient.BaseUrlPokemon = originalBaseURL
-	}()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(nil))
 
 	// Should handle nil cache gracefully
-	result, err := pokeclient.GetPokemon("bulbasaur", nil)
+	result, err := client.GetPokemon(context.Background(), "bulbasaur")
 	if err != nil {
 		t.Fatalf("Expected no error with nil cache, got %v", err)
 	}
@@ -222,6 +214,7 @@ func TestGetPokemonURLConstruction(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestedPath = r.URL.Path
 		response := `{"name": "test", "base_experience": 100}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
@@ -230,24 +223,63 @@ func TestGetPokemonURLConstruction(t *testing.T) {
 	cache := pokecache.NewCache(5*time.Second, context.Background())
 	defer cache.Close()
 
-	originalBaseURL := pokeclient.BaseUrlPokemon
-	pokeclient.BaseUrlPokemon = server.URL
-	defer func() {
-		pokeclient.BaseUrlPokemon = originalBaseURL
-	}()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
 
 	pokemonName := "mewtwo"
-	_, err := pokeclient.GetPokemon(pokemonName, cache)
+	_, err := client.GetPokemon(context.Background(), pokemonName)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 
-	expectedPath := "/" + pokemonName
+	expectedPath := "/pokemon/" + pokemonName
 	if requestedPath != expectedPath {
 		t.Errorf("Expected request path %s, got %s", expectedPath, requestedPath)
 	}
 }
 
+func TestGetPokemonEscapesName(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	for _, name := range []string{"#0", "?x", "a/b"} {
+		if _, err := client.GetPokemon(context.Background(), name); !errors.Is(err, pokeclient.ErrPokemonNotFound) {
+			t.Errorf("GetPokemon(%q): expected ErrPokemonNotFound, got %v", name, err)
+		}
+	}
+
+	expected := []string{"/pokemon/%230", "/pokemon/%3Fx", "/pokemon/a%2Fb"}
+	if !slices.Equal(requested, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requested)
+	}
+}
+
+func TestGetPokemonRejectsDotSegments(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Servers normalize dot segments, which reaches a listing.
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"pokemon": "https://pokeapi.co/api/v2/pokemon/"}`))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	for _, name := range []string{"", ".", ".."} {
+		if _, err := client.GetPokemon(context.Background(), name); !errors.Is(err, pokeclient.ErrPokemonNotFound) {
+			t.Errorf("GetPokemon(%q): expected ErrPokemonNotFound, got %v", name, err)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests for dot segments, got %d", requests)
+	}
+}
+
 func TestErrPokemonNotFound(t *testing.T) {
 	// Verify the error is defined and can be used with errors.Is
 	err := pokeclient.ErrPokemonNotFound
@@ -259,3 +291,82 @@ func TestErrPokemonNotFound(t *testing.T) {
 		t.Error("errors.Is should work with ErrPokemonNotFound")
 	}
 }
+
+func TestParseDexNumber(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected int
+		ok       bool
+	}{
+		{key: "#25", expected: 25, ok: true},
+		{key: "#0025", expected: 25, ok: true},
+		{key: "25", ok: false},
+		{key: "#0", ok: false},
+		{key: "#pikachu", ok: false},
+		{key: "pikachu", ok: false},
+	}
+
+	for _, tt := range tests {
+		n, ok := pokeclient.ParseDexNumber(tt.key)
+		if n != tt.expected || ok != tt.ok {
+			t.Errorf("ParseDexNumber(%q): expected %d, %v, got %d, %v", tt.key, tt.expected, tt.ok, n, ok)
+		}
+	}
+}
+
+func TestResolvePokemonName(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pokemon-species/386":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": 386, "name": "deoxys", "varieties": [{"is_default": true, "pokemon": {"name": "deoxys-normal"}}]}`))
+		case "/pokemon/10001":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": 10001, "name": "deoxys-attack", "species": {"name": "deoxys", "url": "https://pokeapi.co/api/v2/pokemon-species/386/"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{key: "#386", expected: "deoxys-normal"},
+		{key: "10001", expected: "deoxys-attack"},
+		{key: "pikachu", expected: "pikachu"},
+	}
+	for _, tt := range tests {
+		name, err := client.ResolvePokemonName(ctx, tt.key)
+		if err != nil {
+			t.Errorf("ResolvePokemonName(%q): unexpected error: %v", tt.key, err)
+		}
+		if name != tt.expected {
+			t.Errorf("ResolvePokemonName(%q): expected %s, got %s", tt.key, tt.expected, name)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Expected names to resolve without a request, got %d requests", requests)
+	}
+
+	for _, key := range []string{"#9999", "99999", "#0", "#abc"} {
+		if _, err := client.ResolvePokemonName(ctx, key); !errors.Is(err, pokeclient.ErrPokemonNotFound) {
+			t.Errorf("ResolvePokemonName(%q): expected ErrPokemonNotFound, got %v", key, err)
+		}
+	}
+
+	pokemon, err := client.GetPokemon(ctx, "10001")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pokemon.ID != 10001 || pokemon.DexNumber() != 386 {
+		t.Errorf("Expected ID 10001 and dex number 386, got %d and %d", pokemon.ID, pokemon.DexNumber())
+	}
+}
@@ -1,54 +1,48 @@
 package pokeclient
 
-import (
-	"encoding/json"
-	"errors"
-	"io"
-	"net/http"
-
-	"github.com/jabreu610/pokedexcli/internal/pokecache"
-)
+import "context"
 
 type PokemonEntry struct {
 	Name string `json:"name"`
 }
 
 type EncounterEntry struct {
-	Pokemon PokemonEntry `json:"pokemon"`
+	Pokemon        PokemonEntry             `json:"pokemon"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
 }
 
 type LocationAreaByNameResponse struct {
+	ID                int              `json:"id"`
+	Name              string           `json:"name"`
+	Location          Entry            `json:"location"`
 	PokemonEncounters []EncounterEntry `json:"pokemon_encounters"`
+	Names             []Name           `json:"names"`
 }
 
-func GetPokemonForLocationName(name string, cache *pokecache.Cache) ([]string, error) {
-	resParsed := LocationAreaByNameResponse{}
-	out := []string{}
-	var d []byte
-	fullUrl := BaseUrlLocationArea + "/" + name
-	d, ok := cache.Get(fullUrl)
-	if !ok {
-		res, err := http.Get(fullUrl)
-		if err != nil {
-			return out, err
-		}
-		defer res.Body.Close()
-		if res.StatusCode == 404 {
-			return out, errors.New("")
-		}
-
-		d, err = io.ReadAll(res.Body)
-		if err != nil {
-			return out, err
-		}
-		cache.Add(fullUrl, d)
-	}
+// LocalizedName returns the name of the location area in the given language,
+// falling back to Name.
+func (r LocationAreaByNameResponse) LocalizedName(language string) string {
+	return localizedName(r.Names, language, r.Name)
+}
 
-	if err := json.Unmarshal(d, &resParsed); err != nil {
+func (c *Client) GetPokemonForLocationName(ctx context.Context, name string) ([]string, error) {
+	out := []string{}
+	encounters, err := c.GetLocationAreaEncounters(ctx, name)
+	if err != nil {
 		return out, err
 	}
-	for _, entry := range resParsed.PokemonEncounters {
+	for _, entry := range encounters {
 		out = append(out, entry.Pokemon.Name)
 	}
 	return out, nil
 }
+
+// GetLocationAreaEncounters returns every pokemon that can be encountered in
+// a location area, with how it is encountered in each game version.
+func (c *Client) GetLocationAreaEncounters(ctx context.Context, name string) ([]EncounterEntry, error) {
+	resParsed, err := fetchResource[LocationAreaByNameResponse](ctx, c, ErrLocationAreaNotFound, "location-area", name)
+	if err != nil {
+		return nil, err
+	}
+	return resParsed.PokemonEncounters, nil
+}
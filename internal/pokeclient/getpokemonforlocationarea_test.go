@@ -79,6 +79,7 @@ func TestGetPokemonForLocationName(t *testing.T) {
 					t.Errorf("Expected path to end with %s, got %s", expectedPath, r.URL.Path)
 				}
 
+				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(tt.serverStatus)
 				w.Write([]byte(tt.serverResponse))
 			}))
@@ -87,14 +88,9 @@ func TestGetPokemonForLocationName(t *testing.T) {
 			cache := pokecache.NewCache(5*time.Second, context.Background())
 			defer cache.Close()
 
-			// Replace the base URL for testing
-			originalBaseURL := pokeclient.BaseUrlLocationArea
-			pokeclient.BaseUrlLocationArea = server.URL
-			defer func() {
-				pokeclient.BaseUrlLocationArea = originalBaseURL
-			}()
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
 
-			result, err := pokeclient.GetPokemonForLocationName(tt.locationName, cache)
+			result, err := client.GetPokemonForLocationName(context.Background(), tt.locationName)
 
 			if tt.expectError && err == nil {
 				t.Error("Expected error but got none")
@@ -128,6 +124,7 @@ func TestGetPokemonForLocationNameCaching(t *testing.T) {
 				{"pokemon": {"name": "pikachu"}}
 			]
 		}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
@@ -136,14 +133,10 @@ func TestGetPokemonForLocationNameCaching(t *testing.T) {
 	cache := pokecache.NewCache(5*time.Second, context.Background())
 	defer cache.Close()
 
-	originalBaseURL := pokeclient.BaseUrlLocationArea
-	pokeclient.BaseUrlLocationArea = server.URL
-	defer func() {
-		pokeclient.BaseUrlLocationArea = originalBaseURL
-	}()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
 
 	// First call - should hit the server
-	result1, err := pokeclient.GetPokemonForLocationName("test-area", cache)
+	result1, err := client.GetPokemonForLocationName(context.Background(), "test-area")
 	if err != nil {
 		t.Fatalf("First call failed: %v", err)
 	}
@@ -153,7 +146,7 @@ func TestGetPokemonForLocationNameCaching(t *testing.T) {
 	}
 
 	// Second call - should use cache
-	result2, err := pokeclient.GetPokemonForLocationName("test-area", cache)
+	result2, err := client.GetPokemonForLocationName(context.Background(), "test-area")
 	if err != nil {
 		t.Fatalf("Second call failed: %v", err)
 	}
@@ -178,19 +171,16 @@ func TestGetPokemonForLocationNameNilCache(t *testing.T) {
 				{"pokemon": {"name": "charmander"}}
 			]
 		}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
 	defer server.Close()
 
-	originalBaseURL := pokeclient.BaseUrlLocationArea
-	pokeclient.BaseUrlLocationArea = server.URL
-	defer func() {
-		pokeclient.BaseUrlLocationArea = originalBaseURL
-	}()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(nil))
 
 	// Should handle nil cache gracefully
-	result, err := pokeclient.GetPokemonForLocationName("test-area", nil)
+	result, err := client.GetPokemonForLocationName(context.Background(), "test-area")
 	if err != nil {
 		t.Fatalf("Expected no error with nil cache, got %v", err)
 	}
@@ -208,6 +198,7 @@ func TestGetPokemonForLocationNameURLConstruction(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestedPath = r.URL.Path
 		response := `{"pokemon_encounters": []}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
@@ -216,19 +207,15 @@ func TestGetPokemonForLocationNameURLConstruction(t *testing.T) {
 	cache := pokecache.NewCache(5*time.Second, context.Background())
 	defer cache.Close()
 
-	originalBaseURL := pokeclient.BaseUrlLocationArea
-	pokeclient.BaseUrlLocationArea = server.URL
-	defer func() {
-		pokeclient.BaseUrlLocationArea = originalBaseURL
-	}()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
 
 	locationName := "viridian-forest"
-	_, err := pokeclient.GetPokemonForLocationName(locationName, cache)
+	_, err := client.GetPokemonForLocationName(context.Background(), locationName)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 
-	expectedPath := "/" + locationName
+	expectedPath := "/location-area/" + locationName
 	if requestedPath != expectedPath {
 		t.Errorf("Expected request path %s, got %s", expectedPath, requestedPath)
 	}
@@ -0,0 +1,61 @@
+package pokeclient
+
+import "context"
+
+// ItemHolderVersion is how likely a wild pokemon is to hold an item in one
+// game version.
+type ItemHolderVersion struct {
+	Rarity  int   `json:"rarity"`
+	Version Entry `json:"version"`
+}
+
+// ItemHolder is a pokemon that can be found holding an item in the wild.
+type ItemHolder struct {
+	Pokemon        Entry               `json:"pokemon"`
+	VersionDetails []ItemHolderVersion `json:"version_details"`
+}
+
+type Item struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Cost int    `json:"cost"`
+	// FlingPower is the power of the move Fling with this item, nil when the
+	// item cannot be flung.
+	FlingPower    *int          `json:"fling_power"`
+	FlingEffect   *Entry        `json:"fling_effect"`
+	Attributes    []Entry       `json:"attributes"`
+	Category      Entry         `json:"category"`
+	EffectEntries []EffectEntry `json:"effect_entries"`
+	HeldByPokemon []ItemHolder  `json:"held_by_pokemon"`
+}
+
+// Description returns the full effect text in the given language, or an
+// empty string.
+func (i Item) Description(language string) string {
+	return effectText(i.EffectEntries, language, false)
+}
+
+type ItemCategory struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Items  []Entry `json:"items"`
+	Pocket Entry   `json:"pocket"`
+}
+
+func (c *Client) GetItem(ctx context.Context, name string) (Item, error) {
+	return fetchResource[Item](ctx, c, ErrItemNotFound, "item", name)
+}
+
+func (c *Client) GetItemCategory(ctx context.Context, name string) (ItemCategory, error) {
+	return fetchResource[ItemCategory](ctx, c, ErrItemCategoryNotFound, "item-category", name)
+}
+
+// GetItemCategories fetches a page of item categories. An empty url fetches
+// the first page, later pages are reached through the Next and Previous links
+// of the response.
+func (c *Client) GetItemCategories(ctx context.Context, url string) (Page[Entry], error) {
+	if url == "" {
+		url = c.endpoint("item-category")
+	}
+	return Fetch[Page[Entry]](ctx, c, url, nil)
+}
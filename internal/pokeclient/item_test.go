@@ -0,0 +1,311 @@
+package pokeclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const leftovers = `{
+	"id": 211,
+	"name": "leftovers",
+	"cost": 4000,
+	"fling_power": 10,
+	"fling_effect": null,
+	"attributes": [{"name": "holdable"}, {"name": "holdable-active"}],
+	"category": {"name": "held-items"},
+	"effect_entries": [
+		{"effect": "Held: Restores 1/16 of\nthe holder's max HP.", "short_effect": "Restores 1/16 max HP each turn.", "language": {"name": "en"}}
+	],
+	"held_by_pokemon": [
+		{"pokemon": {"name": "snorlax"}, "version_details": [{"rarity": 100, "version": {"name": "red"}}]}
+	]
+}`
+
+func TestGetItem(t *testing.T) {
+	tests := []struct {
+		name                string
+		itemName            string
+		serverResponse      string
+		serverStatus        int
+		expectError         bool
+		expectNotFoundErr   bool
+		expectedCost        int
+		expectedCategory    string
+		expectedFlingPower  *int
+		expectedDescription string
+	}{
+		{
+			name:                "successful response",
+			itemName:            "leftovers",
+			serverResponse:      leftovers,
+			serverStatus:        http.StatusOK,
+			expectedCost:        4000,
+			expectedCategory:    "held-items",
+			expectedFlingPower:  intPtr(10),
+			expectedDescription: "Held: Restores 1/16 of the holder's max HP.",
+		},
+		{
+			name:             "item that cannot be flung",
+			itemName:         "master-ball",
+			serverResponse:   `{"id": 1, "name": "master-ball", "cost": 0, "fling_power": null, "category": {"name": "standard-balls"}}`,
+			serverStatus:     http.StatusOK,
+			expectedCategory: "standard-balls",
+		},
+		{
+			name:              "item not found - 404",
+			itemName:          "fake-item",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			itemName:     "leftovers",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			itemName:       "leftovers",
+			serverResponse: `{"name": "leftovers", "cost": "4000"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			itemName:       "leftovers",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			item, err := client.GetItem(context.Background(), tt.itemName)
+
+			if requestedPath != "/item/"+tt.itemName {
+				t.Errorf("Expected request path /item/%s, got %s", tt.itemName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrItemNotFound) {
+				t.Errorf("Expected ErrItemNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if item.Cost != tt.expectedCost || item.Category.Name != tt.expectedCategory {
+					t.Errorf("Expected %s costing %d, got %s costing %d", tt.expectedCategory, tt.expectedCost, item.Category.Name, item.Cost)
+				}
+				if !equalOptional(item.FlingPower, tt.expectedFlingPower) {
+					t.Errorf("Expected fling power %s, got %s", optionalString(tt.expectedFlingPower), optionalString(item.FlingPower))
+				}
+				if description := item.Description("en"); description != tt.expectedDescription {
+					t.Errorf("Expected description %q, got %q", tt.expectedDescription, description)
+				}
+			}
+		})
+	}
+}
+
+func TestItemHeldByPokemon(t *testing.T) {
+	var item pokeclient.Item
+	if err := json.Unmarshal([]byte(leftovers), &item); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(item.Attributes) != 2 {
+		t.Errorf("Expected 2 attributes, got %d", len(item.Attributes))
+	}
+	if len(item.HeldByPokemon) != 1 || item.HeldByPokemon[0].VersionDetails[0].Rarity != 100 {
+		t.Errorf("Expected snorlax to always hold leftovers, got %+v", item.HeldByPokemon)
+	}
+}
+
+func TestGetItemCategory(t *testing.T) {
+	tests := []struct {
+		name              string
+		categoryName      string
+		serverResponse    string
+		serverStatus      int
+		expectError       bool
+		expectNotFoundErr bool
+		expectedItems     []string
+		expectedPocket    string
+	}{
+		{
+			name:           "successful response",
+			categoryName:   "held-items",
+			serverResponse: `{"id": 12, "name": "held-items", "items": [{"name": "leftovers"}, {"name": "shell-bell"}], "pocket": {"name": "misc"}}`,
+			serverStatus:   http.StatusOK,
+			expectedItems:  []string{"leftovers", "shell-bell"},
+			expectedPocket: "misc",
+		},
+		{
+			name:           "category without items",
+			categoryName:   "unused",
+			serverResponse: `{"id": 43, "name": "unused", "items": [], "pocket": {"name": "misc"}}`,
+			serverStatus:   http.StatusOK,
+			expectedPocket: "misc",
+		},
+		{
+			name:              "category not found - 404",
+			categoryName:      "fake-category",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			categoryName: "held-items",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			categoryName:   "held-items",
+			serverResponse: `{"name": "held-items", "items": {"name": "leftovers"}}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			categoryName:   "held-items",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			category, err := client.GetItemCategory(context.Background(), tt.categoryName)
+
+			if requestedPath != "/item-category/"+tt.categoryName {
+				t.Errorf("Expected request path /item-category/%s, got %s", tt.categoryName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrItemCategoryNotFound) {
+				t.Errorf("Expected ErrItemCategoryNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				var items []string
+				for _, item := range category.Items {
+					items = append(items, item.Name)
+				}
+				if !slices.Equal(items, tt.expectedItems) {
+					t.Errorf("Expected items %v, got %v", tt.expectedItems, items)
+				}
+				if category.Pocket.Name != tt.expectedPocket {
+					t.Errorf("Expected pocket %s, got %s", tt.expectedPocket, category.Pocket.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestGetItemCategories(t *testing.T) {
+	tests := []struct {
+		name            string
+		serverResponse  string
+		serverStatus    int
+		expectError     bool
+		expectedResults int
+		expectNext      bool
+	}{
+		{
+			name:            "first page",
+			serverResponse:  `{"count": 3, "next": "{server}/item-category?offset=2&limit=2", "previous": null, "results": [{"name": "stat-boosts"}, {"name": "effort-drop"}]}`,
+			serverStatus:    http.StatusOK,
+			expectedResults: 2,
+			expectNext:      true,
+		},
+		{
+			name:            "last page",
+			serverResponse:  `{"count": 3, "next": null, "previous": "{server}/item-category", "results": [{"name": "held-items"}]}`,
+			serverStatus:    http.StatusOK,
+			expectedResults: 1,
+		},
+		{
+			name:         "server error",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(strings.ReplaceAll(tt.serverResponse, "{server}", server.URL)))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			page, err := client.GetItemCategories(context.Background(), "")
+
+			if requestedPath != "/item-category" {
+				t.Errorf("Expected request path /item-category, got %s", requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if !tt.expectError {
+				if len(page.Results) != tt.expectedResults {
+					t.Errorf("Expected %d categories, got %d", tt.expectedResults, len(page.Results))
+				}
+				if (page.Next != nil) != tt.expectNext {
+					t.Errorf("Expected next link %v, got %v", tt.expectNext, page.Next)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package pokeclient
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"sync"
+)
+
+// DefaultLanguage is the language of resource names such as "pikachu", and
+// the language text is shown in unless another is chosen.
+const DefaultLanguage = "en"
+
+// Name is the name of a resource in one language.
+type Name struct {
+	Name     string `json:"name"`
+	Language Entry  `json:"language"`
+}
+
+func localizedName(names []Name, language, fallback string) string {
+	for _, name := range names {
+		if name.Language.Name == language {
+			return name.Name
+		}
+	}
+	return fallback
+}
+
+type Language struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Official bool   `json:"official"`
+	Iso639   string `json:"iso639"`
+	Iso3166  string `json:"iso3166"`
+	Names    []Name `json:"names"`
+}
+
+// LocalizedName returns the name of the language in the given language,
+// falling back to Name.
+func (l Language) LocalizedName(language string) string {
+	return localizedName(l.Names, language, l.Name)
+}
+
+func (c *Client) GetLanguage(ctx context.Context, name string) (Language, error) {
+	return fetchResource[Language](ctx, c, ErrLanguageNotFound, "language", name)
+}
+
+// speciesNameIndex maps the lowercase name of every species in every
+// language to the species resource name. Species that could not be fetched
+// are left pending and retried once, on a later lookup.
+type speciesNameIndex struct {
+	mu      sync.Mutex
+	names   map[string]map[string]string
+	listed  bool
+	pending []string
+}
+
+// SpeciesIndexPending reports whether FindSpecies will fetch species to
+// index their names when it is given a name that has not been indexed.
+func (c *Client) SpeciesIndexPending() bool {
+	c.speciesNames.mu.Lock()
+	defer c.speciesNames.mu.Unlock()
+	return !c.speciesNames.listed || len(c.speciesNames.pending) > 0
+}
+
+func (x *speciesNameIndex) lookup(language, name string) (string, bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	species, ok := x.names[language][strings.ToLower(name)]
+	return species, ok
+}
+
+// FindSpecies returns the species called name in the given language, ignoring
+// case. The first lookup of a name that is not yet indexed fetches every
+// species to index their names, which takes a while, and the index is kept
+// for the life of the client.
+func (c *Client) FindSpecies(ctx context.Context, name, language string) (PokemonSpecies, error) {
+	species, ok := c.speciesNames.lookup(language, name)
+	if !ok {
+		if err := c.indexSpeciesNames(ctx); err != nil {
+			return PokemonSpecies{}, err
+		}
+		species, ok = c.speciesNames.lookup(language, name)
+	}
+	if !ok {
+		return PokemonSpecies{}, ErrSpeciesNotFound
+	}
+	return c.GetPokemonSpecies(ctx, species)
+}
+
+// indexSpeciesNames fetches the species missing from the name index. Callers
+// share a single build, and the index lock is not held while downloading.
+func (c *Client) indexSpeciesNames(ctx context.Context) error {
+	x := &c.speciesNames
+	_, _, err := c.flights.do(ctx, "species name index", func(ctx context.Context) ([]byte, error) {
+		x.mu.Lock()
+		listed, names := x.listed, x.pending
+		x.mu.Unlock()
+		if listed && len(names) == 0 {
+			return nil, nil
+		}
+
+		if !listed {
+			names = nil
+			for entry, err := range List[Entry](ctx, c, c.endpoint("pokemon-species"), ListOptions{PageSize: 200}) {
+				if err != nil {
+					return nil, err
+				}
+				names = append(names, entry.Name)
+			}
+		}
+		var pending []string
+		index := map[string]map[string]string{}
+		for _, result := range Batch(ctx, c, names, c.GetPokemonSpecies) {
+			if result.Err != nil {
+				pending = append(pending, result.Key)
+				continue
+			}
+			for _, name := range result.Value.Names {
+				language := name.Language.Name
+				if index[language] == nil {
+					index[language] = map[string]string{}
+				}
+				index[language][strings.ToLower(name.Name)] = result.Value.Name
+			}
+		}
+
+		x.mu.Lock()
+		defer x.mu.Unlock()
+		if x.names == nil {
+			x.names = map[string]map[string]string{}
+		}
+		for language, names := range index {
+			if x.names[language] == nil {
+				x.names[language] = map[string]string{}
+			}
+			maps.Copy(x.names[language], names)
+		}
+		if len(pending) > 0 {
+			c.logger.DebugContext(ctx, "species left out of the name index", "count", len(pending), "retry", !listed)
+		}
+		// Species that fail again are not retried, so a name that is not
+		// indexed does not keep sending requests.
+		if listed {
+			pending = nil
+		}
+		x.listed = true
+		x.pending = pending
+		return nil, nil
+	})
+	return err
+}
@@ -0,0 +1,228 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestGetLanguage(t *testing.T) {
+	tests := []struct {
+		name              string
+		languageName      string
+		serverResponse    string
+		serverStatus      int
+		expectError       bool
+		expectNotFoundErr bool
+		expectedNames     map[string]string
+	}{
+		{
+			name:           "successful response",
+			languageName:   "ja",
+			serverResponse: `{"id": 11, "name": "ja", "official": true, "iso639": "ja", "iso3166": "jp", "names": [{"name": "日本語", "language": {"name": "ja"}}, {"name": "Japanese", "language": {"name": "en"}}]}`,
+			serverStatus:   http.StatusOK,
+			// A missing language falls back to the resource name
+			expectedNames: map[string]string{"en": "Japanese", "ja": "日本語", "fr": "ja"},
+		},
+		{
+			name:              "language not found - 404",
+			languageName:      "xx",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			languageName: "ja",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			languageName:   "ja",
+			serverResponse: `{"name": "ja", "official": "yes"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			languageName:   "ja",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			language, err := client.GetLanguage(context.Background(), tt.languageName)
+
+			if requestedPath != "/language/"+tt.languageName {
+				t.Errorf("Expected request path /language/%s, got %s", tt.languageName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrLanguageNotFound) {
+				t.Errorf("Expected ErrLanguageNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				for in, expected := range tt.expectedNames {
+					if name := language.LocalizedName(in); name != expected {
+						t.Errorf("Expected the name %q in %s, got %q", expected, in, name)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFindSpecies(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pokemon-species":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count": 3, "next": null, "previous": null, "results": [{"name": "pikachu"}, {"name": "deoxys"}, {"name": "mew"}]}`))
+		case "/pokemon-species/pikachu":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "pikachu", "names": [{"name": "ピカチュウ", "language": {"name": "ja"}}, {"name": "Pikachu", "language": {"name": "de"}}],
+				"varieties": [{"is_default": true, "pokemon": {"name": "pikachu"}}]}`))
+		case "/pokemon-species/deoxys":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "deoxys", "names": [{"name": "デオキシス", "language": {"name": "ja"}}],
+				"varieties": [{"is_default": true, "pokemon": {"name": "deoxys-normal"}}, {"is_default": false, "pokemon": {"name": "deoxys-attack"}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	species, err := client.FindSpecies(ctx, "ピカチュウ", "ja")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if species.Name != "pikachu" || species.DefaultPokemon() != "pikachu" {
+		t.Errorf("Expected pikachu, got %s", species.Name)
+	}
+
+	before := requests.Load()
+	species, err = client.FindSpecies(ctx, "デオキシス", "ja")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if species.DefaultPokemon() != "deoxys-normal" {
+		t.Errorf("Expected deoxys-normal as the default pokemon, got %s", species.DefaultPokemon())
+	}
+	if requests.Load()-before > 1 {
+		t.Errorf("Expected the name index to be reused, got %d more requests", requests.Load()-before)
+	}
+
+	if _, err := client.FindSpecies(ctx, "pikachu", "de"); err != nil {
+		t.Errorf("Expected names to match ignoring case, got %v", err)
+	}
+	if _, err := client.FindSpecies(ctx, "ピカチュウ", "de"); !errors.Is(err, pokeclient.ErrSpeciesNotFound) {
+		t.Errorf("Expected ErrSpeciesNotFound for a name in another language, got %v", err)
+	}
+}
+
+func TestFindSpeciesKeepsPartialIndex(t *testing.T) {
+	var deoxysRequests, mewRequests, listRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pokemon-species":
+			listRequests.Add(1)
+			w.Write([]byte(`{"count": 3, "next": null, "previous": null, "results": [{"name": "pikachu"}, {"name": "deoxys"}, {"name": "mew"}]}`))
+		case "/pokemon-species/pikachu":
+			w.Write([]byte(`{"name": "pikachu", "names": [{"name": "ピカチュウ", "language": {"name": "ja"}}]}`))
+		case "/pokemon-species/deoxys":
+			// Fails the first time, while the index is built.
+			if deoxysRequests.Add(1) == 1 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"name": "deoxys", "names": [{"name": "デオキシス", "language": {"name": "ja"}}]}`))
+		case "/pokemon-species/mew":
+			// Always fails.
+			mewRequests.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	if !client.SpeciesIndexPending() {
+		t.Error("Expected the index to be pending before the first lookup")
+	}
+	if _, err := client.FindSpecies(ctx, "ピカチュウ", "ja"); err != nil {
+		t.Fatalf("Expected species that were fetched to be indexed, got %v", err)
+	}
+	species, err := client.FindSpecies(ctx, "デオキシス", "ja")
+	if err != nil {
+		t.Fatalf("Expected the failed species to be indexed on a later lookup, got %v", err)
+	}
+	if species.Name != "deoxys" {
+		t.Errorf("Expected deoxys, got %s", species.Name)
+	}
+	if client.SpeciesIndexPending() {
+		t.Error("Expected failed species to be retried only once")
+	}
+	for range 2 {
+		if _, err := client.FindSpecies(ctx, "ライチュウ", "ja"); !errors.Is(err, pokeclient.ErrSpeciesNotFound) {
+			t.Errorf("Expected ErrSpeciesNotFound, got %v", err)
+		}
+	}
+	if mewRequests.Load() != 2 {
+		t.Errorf("Expected a species that keeps failing to be fetched twice, got %d requests", mewRequests.Load())
+	}
+	if listRequests.Load() != 1 {
+		t.Errorf("Expected species to be listed once, got %d list requests", listRequests.Load())
+	}
+}
+
+func TestLocalizedNames(t *testing.T) {
+	names := []pokeclient.Name{
+		{Name: "10まんボルト", Language: pokeclient.Entry{Name: "ja"}},
+		{Name: "Donnerblitz", Language: pokeclient.Entry{Name: "de"}},
+	}
+	move := pokeclient.Move{Name: "thunderbolt", Names: names}
+	if name := move.LocalizedName("de"); name != "Donnerblitz" {
+		t.Errorf("Expected Donnerblitz, got %s", name)
+	}
+	if name := move.LocalizedName("fr"); name != "thunderbolt" {
+		t.Errorf("Expected the resource name for a missing language, got %s", name)
+	}
+
+	ability := pokeclient.Ability{Name: "static", Names: []pokeclient.Name{{Name: "せいでんき", Language: pokeclient.Entry{Name: "ja"}}}}
+	if name := ability.LocalizedName("ja"); name != "せいでんき" {
+		t.Errorf("Expected せいでんき, got %s", name)
+	}
+}
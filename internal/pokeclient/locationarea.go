@@ -1,47 +1,20 @@
 package pokeclient
 
-import (
-	"encoding/json"
-	"io"
-	"net/http"
-
-	"github.com/jabreu610/pokedexcli/internal/pokecache"
-)
+import "context"
 
 type LocationArea struct {
 	Name string `json:"name"`
 	Url  string `json:"url"`
 }
 
-type LocationAreaResponse struct {
-	Count    int            `json:"count"`
-	Next     *string        `json:"next"`
-	Previous *string        `json:"previous"`
-	Results  []LocationArea `json:"results"`
-}
-
-var BaseUrlLocationArea string = "https://pokeapi.co/api/v2/location-area"
-
-func GetLocationAreas(url string, cache *pokecache.Cache) (LocationAreaResponse, error) {
-	out := LocationAreaResponse{}
-	var d []byte
-	d, ok := cache.Get(url)
-	if !ok {
-		res, err := http.Get(url)
-		if err != nil {
-			return out, err
-		}
-		defer res.Body.Close()
-
-		d, err = io.ReadAll(res.Body)
-		if err != nil {
-			return out, err
-		}
-		cache.Add(url, d)
-	}
+type LocationAreaResponse = Page[LocationArea]
 
-	if err := json.Unmarshal(d, &out); err != nil {
-		return out, err
+// GetLocationAreas fetches a page of location areas. An empty url fetches the
+// first page, later pages are reached through the Next and Previous links of
+// the response.
+func (c *Client) GetLocationAreas(ctx context.Context, url string) (LocationAreaResponse, error) {
+	if url == "" {
+		url = c.endpoint("location-area")
 	}
-	return out, nil
+	return Fetch[LocationAreaResponse](ctx, c, url, nil)
 }
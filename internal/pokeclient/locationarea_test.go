@@ -72,6 +72,7 @@ func TestGetLocationAreas(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(tt.serverStatus)
 				w.Write([]byte(tt.serverResponse))
 			}))
@@ -80,7 +81,8 @@ func TestGetLocationAreas(t *testing.T) {
 			cache := pokecache.NewCache(5*time.Second, context.Background())
 			defer cache.Close()
 
-			result, err := pokeclient.GetLocationAreas(server.URL, cache)
+			client := pokeclient.NewClient(pokeclient.WithCache(cache))
+			result, err := client.GetLocationAreas(context.Background(), server.URL)
 
 			if tt.expectError && err == nil {
 				t.Error("Expected error but got none")
@@ -110,6 +112,7 @@ func TestGetLocationAreasCaching(t *testing.T) {
 			"previous": null,
 			"results": [{"name": "test-area", "url": "https://example.com/1"}]
 		}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
@@ -118,8 +121,10 @@ func TestGetLocationAreasCaching(t *testing.T) {
 	cache := pokecache.NewCache(5*time.Second, context.Background())
 	defer cache.Close()
 
+	client := pokeclient.NewClient(pokeclient.WithCache(cache))
+
 	// First call - should hit the server
-	_, err := pokeclient.GetLocationAreas(server.URL, cache)
+	_, err := client.GetLocationAreas(context.Background(), server.URL)
 	if err != nil {
 		t.Fatalf("First call failed: %v", err)
 	}
@@ -129,7 +134,7 @@ func TestGetLocationAreasCaching(t *testing.T) {
 	}
 
 	// Second call - should use cache
-	_, err = pokeclient.GetLocationAreas(server.URL, cache)
+	_, err = client.GetLocationAreas(context.Background(), server.URL)
 	if err != nil {
 		t.Fatalf("Second call failed: %v", err)
 	}
@@ -147,13 +152,15 @@ func TestGetLocationAreasNilCache(t *testing.T) {
 			"previous": null,
 			"results": [{"name": "test-area", "url": "https://example.com/1"}]
 		}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
 	defer server.Close()
 
 	// Should handle nil cache gracefully
-	result, err := pokeclient.GetLocationAreas(server.URL, nil)
+	client := pokeclient.NewClient(pokeclient.WithCache(nil))
+	result, err := client.GetLocationAreas(context.Background(), server.URL)
 	if err != nil {
 		t.Fatalf("Expected no error with nil cache, got %v", err)
 	}
@@ -175,6 +182,7 @@ func TestGetLocationAreasResponseFields(t *testing.T) {
 				{"name": "test-location", "url": "https://example.com/location"}
 			]
 		}`
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(response))
 	}))
@@ -183,7 +191,8 @@ func TestGetLocationAreasResponseFields(t *testing.T) {
 	cache := pokecache.NewCache(5*time.Second, context.Background())
 	defer cache.Close()
 
-	result, err := pokeclient.GetLocationAreas(server.URL, cache)
+	client := pokeclient.NewClient(pokeclient.WithCache(cache))
+	result, err := client.GetLocationAreas(context.Background(), server.URL)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
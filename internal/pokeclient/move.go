@@ -0,0 +1,76 @@
+package pokeclient
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// MoveVersionDetail is how a pokemon learns a move in one version group.
+type MoveVersionDetail struct {
+	LevelLearnedAt  int   `json:"level_learned_at"`
+	MoveLearnMethod Entry `json:"move_learn_method"`
+	VersionGroup    Entry `json:"version_group"`
+}
+
+type PokemonMove struct {
+	Move                Entry               `json:"move"`
+	VersionGroupDetails []MoveVersionDetail `json:"version_group_details"`
+}
+
+type EffectEntry struct {
+	Effect      string `json:"effect"`
+	ShortEffect string `json:"short_effect"`
+	Language    Entry  `json:"language"`
+}
+
+// effectText returns the effect, or the short effect, of the entry in the
+// given language.
+func effectText(entries []EffectEntry, language string, short bool) string {
+	for _, entry := range entries {
+		if entry.Language.Name != language {
+			continue
+		}
+		if short {
+			return cleanFlavorText(entry.ShortEffect)
+		}
+		return cleanFlavorText(entry.Effect)
+	}
+	return ""
+}
+
+type Move struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Type        Entry  `json:"type"`
+	Power       *int   `json:"power"`
+	Accuracy    *int   `json:"accuracy"`
+	PP          *int   `json:"pp"`
+	Priority    int    `json:"priority"`
+	DamageClass Entry  `json:"damage_class"`
+	// EffectChance is the chance of a secondary effect, referenced as
+	// $effect_chance in the effect text.
+	EffectChance  *int          `json:"effect_chance"`
+	EffectEntries []EffectEntry `json:"effect_entries"`
+	Names         []Name        `json:"names"`
+}
+
+// LocalizedName returns the name of the move in the given language, falling
+// back to Name.
+func (m Move) LocalizedName(language string) string {
+	return localizedName(m.Names, language, m.Name)
+}
+
+// Effect returns the short effect text in the given language, with the
+// effect chance filled in, or an empty string.
+func (m Move) Effect(language string) string {
+	effect := effectText(m.EffectEntries, language, true)
+	if m.EffectChance != nil {
+		effect = strings.ReplaceAll(effect, "$effect_chance", strconv.Itoa(*m.EffectChance))
+	}
+	return effect
+}
+
+func (c *Client) GetMove(ctx context.Context, name string) (Move, error) {
+	return fetchResource[Move](ctx, c, ErrMoveNotFound, "move", name)
+}
@@ -0,0 +1,202 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const thunderboltMove = `{
+	"id": 85,
+	"name": "thunderbolt",
+	"type": {"name": "electric"},
+	"power": 90,
+	"accuracy": 100,
+	"pp": 15,
+	"priority": 0,
+	"damage_class": {"name": "special"},
+	"effect_chance": 10,
+	"effect_entries": [
+		{"effect": "Inflicts regular damage.", "short_effect": "Has a $effect_chance% chance\nto paralyze the target.", "language": {"name": "en"}}
+	]
+}`
+
+func TestGetMove(t *testing.T) {
+	tests := []struct {
+		name                string
+		moveName            string
+		serverResponse      string
+		serverStatus        int
+		expectError         bool
+		expectNotFoundErr   bool
+		expectedDamageClass string
+		expectedPower       *int
+		expectedPP          *int
+		expectedEffect      string
+	}{
+		{
+			name:                "successful response",
+			moveName:            "thunderbolt",
+			serverResponse:      thunderboltMove,
+			serverStatus:        http.StatusOK,
+			expectedDamageClass: "special",
+			expectedPower:       intPtr(90),
+			expectedPP:          intPtr(15),
+			expectedEffect:      "Has a 10% chance to paralyze the target.",
+		},
+		{
+			name:                "status move without power",
+			moveName:            "growl",
+			serverResponse:      `{"name": "growl", "power": null, "accuracy": 100, "pp": 40, "damage_class": {"name": "status"}}`,
+			serverStatus:        http.StatusOK,
+			expectedDamageClass: "status",
+			expectedPP:          intPtr(40),
+		},
+		{
+			name:              "move not found - 404",
+			moveName:          "splashier",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			moveName:     "thunderbolt",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			moveName:       "thunderbolt",
+			serverResponse: `{"name": "thunderbolt", "power": "ninety"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			moveName:       "thunderbolt",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			move, err := client.GetMove(context.Background(), tt.moveName)
+
+			if requestedPath != "/move/"+tt.moveName {
+				t.Errorf("Expected request path /move/%s, got %s", tt.moveName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrMoveNotFound) {
+				t.Errorf("Expected ErrMoveNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if move.Name != tt.moveName || move.DamageClass.Name != tt.expectedDamageClass {
+					t.Errorf("Expected %s %s move, got %s %s", tt.expectedDamageClass, tt.moveName, move.DamageClass.Name, move.Name)
+				}
+				if !equalOptional(move.Power, tt.expectedPower) {
+					t.Errorf("Expected power %v, got %v", optionalString(tt.expectedPower), optionalString(move.Power))
+				}
+				if !equalOptional(move.PP, tt.expectedPP) {
+					t.Errorf("Expected PP %v, got %v", optionalString(tt.expectedPP), optionalString(move.PP))
+				}
+				if effect := move.Effect("en"); effect != tt.expectedEffect {
+					t.Errorf("Expected effect %q, got %q", tt.expectedEffect, effect)
+				}
+			}
+		})
+	}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func equalOptional(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func optionalString(n *int) string {
+	if n == nil {
+		return "null"
+	}
+	return strconv.Itoa(*n)
+}
+
+func TestPokemonMoves(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"name": "pikachu",
+			"moves": [
+				{
+					"move": {"name": "thunder-shock", "url": "https://pokeapi.co/api/v2/move/84/"},
+					"version_group_details": [
+						{"level_learned_at": 1, "move_learn_method": {"name": "level-up"}, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}}
+					]
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	pokemon, err := client.GetPokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pokemon.Moves) != 1 {
+		t.Fatalf("Expected 1 move, got %d", len(pokemon.Moves))
+	}
+	move := pokemon.Moves[0]
+	if move.Move.Name != "thunder-shock" || move.Move.ID() != 84 {
+		t.Errorf("Expected thunder-shock (84), got %s (%d)", move.Move.Name, move.Move.ID())
+	}
+	detail := move.VersionGroupDetails[0]
+	if detail.LevelLearnedAt != 1 || detail.MoveLearnMethod.Name != "level-up" || detail.VersionGroup.ID() != 1 {
+		t.Errorf("Unexpected version group detail %+v", detail)
+	}
+}
+
+func TestEntryID(t *testing.T) {
+	cases := []struct {
+		url      string
+		expected int
+	}{
+		{url: "https://pokeapi.co/api/v2/pokemon/25/", expected: 25},
+		{url: "https://pokeapi.co/api/v2/pokemon/25", expected: 25},
+		{url: "https://pokeapi.co/api/v2/pokemon/pikachu", expected: 0},
+		{url: "", expected: 0},
+	}
+	for _, c := range cases {
+		if id := (pokeclient.Entry{Url: c.url}).ID(); id != c.expected {
+			t.Errorf("Expected ID %d for %q, got %d", c.expected, c.url, id)
+		}
+	}
+}
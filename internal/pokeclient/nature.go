@@ -0,0 +1,88 @@
+package pokeclient
+
+import (
+	"context"
+	"strconv"
+)
+
+// Nature raises one stat by 10% and lowers another by 10%, and sets which
+// flavors a pokemon likes. Neutral natures have no increased or decreased
+// stat.
+type Nature struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	IncreasedStat *Entry `json:"increased_stat"`
+	DecreasedStat *Entry `json:"decreased_stat"`
+	LikesFlavor   *Entry `json:"likes_flavor"`
+	HatesFlavor   *Entry `json:"hates_flavor"`
+}
+
+// Neutral reports whether the nature leaves every stat unchanged.
+func (n Nature) Neutral() bool {
+	return n.IncreasedStat == nil || n.DecreasedStat == nil || n.IncreasedStat.Name == n.DecreasedStat.Name
+}
+
+type Description struct {
+	Description string `json:"description"`
+	Language    Entry  `json:"language"`
+}
+
+func descriptionText(entries []Description, language string) string {
+	for _, entry := range entries {
+		if entry.Language.Name == language {
+			return cleanFlavorText(entry.Description)
+		}
+	}
+	return ""
+}
+
+// GrowthRateLevel is the total experience needed to reach a level.
+type GrowthRateLevel struct {
+	Level      int `json:"level"`
+	Experience int `json:"experience"`
+}
+
+type GrowthRate struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// Formula is the experience formula written in LaTeX.
+	Formula      string            `json:"formula"`
+	Descriptions []Description     `json:"descriptions"`
+	Levels       []GrowthRateLevel `json:"levels"`
+}
+
+// Description returns the description in the given language, or an empty
+// string.
+func (g GrowthRate) Description(language string) string {
+	return descriptionText(g.Descriptions, language)
+}
+
+// Characteristic is the summary shown for a pokemon whose highest IV is
+// HighestStat and whose IV modulo 5 is GeneModulo.
+type Characteristic struct {
+	ID             int           `json:"id"`
+	GeneModulo     int           `json:"gene_modulo"`
+	PossibleValues []int         `json:"possible_values"`
+	HighestStat    Entry         `json:"highest_stat"`
+	Descriptions   []Description `json:"descriptions"`
+}
+
+// Description returns the description in the given language, or an empty
+// string.
+func (c Characteristic) Description(language string) string {
+	return descriptionText(c.Descriptions, language)
+}
+
+func (c *Client) GetNature(ctx context.Context, name string) (Nature, error) {
+	return fetchResource[Nature](ctx, c, ErrNatureNotFound, "nature", name)
+}
+
+func (c *Client) GetGrowthRate(ctx context.Context, name string) (GrowthRate, error) {
+	return fetchResource[GrowthRate](ctx, c, ErrGrowthRateNotFound, "growth-rate", name)
+}
+
+// GetCharacteristic fetches a characteristic by ID, since characteristics
+// have no name.
+func (c *Client) GetCharacteristic(ctx context.Context, id int) (Characteristic, error) {
+	return fetchResource[Characteristic](ctx, c, ErrCharacteristicNotFound, "characteristic", strconv.Itoa(id))
+}
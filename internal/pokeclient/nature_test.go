@@ -0,0 +1,280 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestGetNature(t *testing.T) {
+	tests := []struct {
+		name              string
+		natureName        string
+		serverResponse    string
+		serverStatus      int
+		expectError       bool
+		expectNotFoundErr bool
+		expectNeutral     bool
+		expectedIncreased string
+		expectedLikes     string
+	}{
+		{
+			name:              "successful response",
+			natureName:        "adamant",
+			serverResponse:    `{"id": 3, "name": "adamant", "increased_stat": {"name": "attack"}, "decreased_stat": {"name": "special-attack"}, "likes_flavor": {"name": "spicy"}, "hates_flavor": {"name": "dry"}}`,
+			serverStatus:      http.StatusOK,
+			expectedIncreased: "attack",
+			expectedLikes:     "spicy",
+		},
+		{
+			name:           "neutral nature",
+			natureName:     "hardy",
+			serverResponse: `{"id": 1, "name": "hardy", "increased_stat": null, "decreased_stat": null, "likes_flavor": null, "hates_flavor": null}`,
+			serverStatus:   http.StatusOK,
+			expectNeutral:  true,
+		},
+		{
+			name:              "nature not found - 404",
+			natureName:        "grumpy",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			natureName:   "adamant",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			natureName:     "adamant",
+			serverResponse: `{"name": "adamant", "increased_stat": "attack"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			natureName:     "adamant",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			nature, err := client.GetNature(context.Background(), tt.natureName)
+
+			if requestedPath != "/nature/"+tt.natureName {
+				t.Errorf("Expected request path /nature/%s, got %s", tt.natureName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrNatureNotFound) {
+				t.Errorf("Expected ErrNatureNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if nature.Neutral() != tt.expectNeutral {
+					t.Errorf("Expected neutral %v, got %+v", tt.expectNeutral, nature)
+				}
+				if !tt.expectNeutral && (nature.IncreasedStat.Name != tt.expectedIncreased || nature.LikesFlavor.Name != tt.expectedLikes) {
+					t.Errorf("Expected %s up and liking %s, got %s and %s", tt.expectedIncreased, tt.expectedLikes, nature.IncreasedStat.Name, nature.LikesFlavor.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestGetGrowthRate(t *testing.T) {
+	tests := []struct {
+		name                string
+		rateName            string
+		serverResponse      string
+		serverStatus        int
+		expectError         bool
+		expectNotFoundErr   bool
+		expectedFormula     string
+		expectedLevels      int
+		expectedDescription string
+	}{
+		{
+			name:                "successful response",
+			rateName:            "medium",
+			serverResponse:      `{"id": 2, "name": "medium", "formula": "x^3", "descriptions": [{"description": "medium", "language": {"name": "en"}}], "levels": [{"level": 1, "experience": 0}, {"level": 2, "experience": 8}]}`,
+			serverStatus:        http.StatusOK,
+			expectedFormula:     "x^3",
+			expectedLevels:      2,
+			expectedDescription: "medium",
+		},
+		{
+			name:              "growth rate not found - 404",
+			rateName:          "glacial",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			rateName:     "medium",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			rateName:       "medium",
+			serverResponse: `{"name": "medium", "levels": [{"level": 1, "experience": "none"}]}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			rateName:       "medium",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			rate, err := client.GetGrowthRate(context.Background(), tt.rateName)
+
+			if requestedPath != "/growth-rate/"+tt.rateName {
+				t.Errorf("Expected request path /growth-rate/%s, got %s", tt.rateName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrGrowthRateNotFound) {
+				t.Errorf("Expected ErrGrowthRateNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if rate.Formula != tt.expectedFormula || len(rate.Levels) != tt.expectedLevels {
+					t.Errorf("Expected %d levels of %s, got %d of %s", tt.expectedLevels, tt.expectedFormula, len(rate.Levels), rate.Formula)
+				}
+				if description := rate.Description("en"); description != tt.expectedDescription {
+					t.Errorf("Expected description %q, got %q", tt.expectedDescription, description)
+				}
+			}
+		})
+	}
+}
+
+func TestGetCharacteristic(t *testing.T) {
+	tests := []struct {
+		name                string
+		characteristicID    int
+		serverResponse      string
+		serverStatus        int
+		expectError         bool
+		expectNotFoundErr   bool
+		expectedHighestStat string
+		expectedDescription string
+	}{
+		{
+			name:                "successful response",
+			characteristicID:    1,
+			serverResponse:      `{"id": 1, "gene_modulo": 0, "possible_values": [0, 5, 10], "highest_stat": {"name": "hp"}, "descriptions": [{"description": "Loves to eat", "language": {"name": "en"}}]}`,
+			serverStatus:        http.StatusOK,
+			expectedHighestStat: "hp",
+			expectedDescription: "Loves to eat",
+		},
+		{
+			name:              "characteristic not found - 404",
+			characteristicID:  99,
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:             "server error",
+			characteristicID: 1,
+			serverStatus:     http.StatusInternalServerError,
+			expectError:      true,
+		},
+		{
+			name:             "invalid json",
+			characteristicID: 1,
+			serverResponse:   `{"id": 1, "possible_values": "0, 5, 10"}`,
+			serverStatus:     http.StatusOK,
+			expectError:      true,
+		},
+		{
+			name:             "malformed json",
+			characteristicID: 1,
+			serverResponse:   `{invalid json}`,
+			serverStatus:     http.StatusOK,
+			expectError:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			characteristic, err := client.GetCharacteristic(context.Background(), tt.characteristicID)
+
+			if expected := "/characteristic/" + strconv.Itoa(tt.characteristicID); requestedPath != expected {
+				t.Errorf("Expected request path %s, got %s", expected, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrCharacteristicNotFound) {
+				t.Errorf("Expected ErrCharacteristicNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if characteristic.HighestStat.Name != tt.expectedHighestStat {
+					t.Errorf("Expected highest stat %s, got %s", tt.expectedHighestStat, characteristic.HighestStat.Name)
+				}
+				if description := characteristic.Description("en"); description != tt.expectedDescription {
+					t.Errorf("Expected description %q, got %q", tt.expectedDescription, description)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package pokeclient
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// Page is one page of a PokeAPI list endpoint.
+type Page[T any] struct {
+	Count    int     `json:"count"`
+	Next     *string `json:"next"`
+	Previous *string `json:"previous"`
+	Results  []T     `json:"results"`
+}
+
+// ListOptions controls how List pages through a list endpoint.
+type ListOptions struct {
+	// PageSize is the number of results requested per page. Zero uses the
+	// PokeAPI default of 20.
+	PageSize int
+}
+
+// List returns an iterator over every result of the list endpoint at
+// listURL, following next links until the last page. Pages are only fetched
+// as the iteration reaches them, so breaking out of the loop early stops
+// further requests. The first error, including cancellation of ctx, is
+// yielded with a zero T and ends the iteration.
+func List[T any](ctx context.Context, c *Client, listURL string, opts ListOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		next, err := withPageSize(listURL, opts.PageSize)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		for next != "" {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := Fetch[Page[T]](ctx, c, next, nil)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, result := range page.Results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+			next = ""
+			if page.Next != nil {
+				next = *page.Next
+			}
+		}
+	}
+}
+
+func withPageSize(listURL string, pageSize int) (string, error) {
+	if pageSize <= 0 {
+		return listURL, nil
+	}
+	u, err := url.Parse(listURL)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set("limit", strconv.Itoa(pageSize))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// AllLocationAreas iterates over every location area.
+func (c *Client) AllLocationAreas(ctx context.Context, opts ListOptions) iter.Seq2[LocationArea, error] {
+	return List[LocationArea](ctx, c, c.endpoint("location-area"), opts)
+}
+
+// AllPokemon iterates over every pokemon.
+func (c *Client) AllPokemon(ctx context.Context, opts ListOptions) iter.Seq2[Entry, error] {
+	return List[Entry](ctx, c, c.endpoint("pokemon"), opts)
+}
@@ -0,0 +1,168 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+// newListServer serves total location areas named area-0, area-1, ... in
+// pages, honoring the limit and offset query parameters like PokeAPI.
+func newListServer(total int, requests *[]string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			limit = 20
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		results := "["
+		for i := offset; i < min(offset+limit, total); i++ {
+			if i > offset {
+				results += ","
+			}
+			results += fmt.Sprintf(`{"name": "area-%d", "url": ""}`, i)
+		}
+		results += "]"
+		next := "null"
+		if offset+limit < total {
+			next = fmt.Sprintf(`"%s%s?offset=%d&limit=%d"`, server.URL, r.URL.Path, offset+limit, limit)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"count": %d, "next": %s, "previous": null, "results": %s}`, total, next, results)
+	}))
+	return server
+}
+
+func TestAllLocationAreas(t *testing.T) {
+	var requests []string
+	server := newListServer(45, &requests)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+
+	count := 0
+	for area, err := range client.AllLocationAreas(context.Background(), pokeclient.ListOptions{}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := fmt.Sprintf("area-%d", count); area.Name != expected {
+			t.Errorf("Expected %s, got %s", expected, area.Name)
+		}
+		count++
+	}
+
+	if count != 45 {
+		t.Errorf("Expected 45 location areas, got %d", count)
+	}
+	if len(requests) != 3 {
+		t.Errorf("Expected 3 pages with the default page size, got %d", len(requests))
+	}
+}
+
+func TestListPageSize(t *testing.T) {
+	var requests []string
+	server := newListServer(45, &requests)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+
+	count := 0
+	for _, err := range client.AllPokemon(context.Background(), pokeclient.ListOptions{PageSize: 100}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		count++
+	}
+
+	if count != 45 {
+		t.Errorf("Expected 45 results, got %d", count)
+	}
+	if len(requests) != 1 || requests[0] != "limit=100" {
+		t.Errorf("Expected a single request with limit=100, got %v", requests)
+	}
+}
+
+func TestListEarlyTermination(t *testing.T) {
+	var requests []string
+	server := newListServer(100, &requests)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+
+	count := 0
+	for _, err := range client.AllLocationAreas(context.Background(), pokeclient.ListOptions{PageSize: 10}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		count++
+		if count == 15 {
+			break
+		}
+	}
+
+	if len(requests) != 2 {
+		t.Errorf("Expected breaking out of the loop to stop paging after 2 pages, got %d", len(requests))
+	}
+}
+
+func TestListError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+
+	errCount := 0
+	for _, err := range client.AllLocationAreas(context.Background(), pokeclient.ListOptions{}) {
+		var statusErr *pokeclient.HTTPStatusError
+		if !errors.As(err, &statusErr) {
+			t.Errorf("Expected *HTTPStatusError, got %v", err)
+		}
+		errCount++
+	}
+	if errCount != 1 {
+		t.Errorf("Expected iteration to end after the first error, got %d errors", errCount)
+	}
+}
+
+func TestListContextCancellation(t *testing.T) {
+	var requests []string
+	server := newListServer(100, &requests)
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var lastErr error
+	count := 0
+	for _, err := range client.AllLocationAreas(ctx, pokeclient.ListOptions{PageSize: 10}) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		count++
+		if count == 10 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", lastErr)
+	}
+	if len(requests) != 1 {
+		t.Errorf("Expected no requests after cancellation, got %d", len(requests))
+	}
+}
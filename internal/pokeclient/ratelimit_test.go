@@ -0,0 +1,117 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func newPokemonServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "jolteon", "base_experience": 184}`))
+	}))
+}
+
+func TestRateLimitBurstDoesNotWait(t *testing.T) {
+	server := newPokemonServer()
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRateLimit(1, 3),
+	)
+	for range 3 {
+		if _, err := client.GetPokemon(context.Background(), "jolteon"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	stats := client.Stats()
+	if stats.Throttled != 0 {
+		t.Errorf("Expected requests within the burst not to wait, %d did", stats.Throttled)
+	}
+	if stats.Requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", stats.Requests)
+	}
+}
+
+func TestRateLimitConcurrent(t *testing.T) {
+	server := newPokemonServer()
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRateLimit(50, 1),
+	)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := range 6 {
+		wg.Go(func() {
+			// Distinct names so the requests are not coalesced.
+			if _, err := client.GetPokemon(context.Background(), fmt.Sprintf("jolteon-%d", i)); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	// One request goes out immediately, the other five are spaced 20ms apart.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected requests to be throttled, all finished in %v", elapsed)
+	}
+	stats := client.Stats()
+	if stats.Throttled != 5 {
+		t.Errorf("Expected 5 throttled requests, got %d", stats.Throttled)
+	}
+	if stats.ThrottleWait <= 0 {
+		t.Errorf("Expected time spent waiting to be reported, got %v", stats.ThrottleWait)
+	}
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	server := newPokemonServer()
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRateLimit(0, 0),
+	)
+	for range 20 {
+		if _, err := client.GetPokemon(context.Background(), "jolteon"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if throttled := client.Stats().Throttled; throttled != 0 {
+		t.Errorf("Expected no throttling, got %d", throttled)
+	}
+}
+
+func TestRateLimitWaitCancelled(t *testing.T) {
+	server := newPokemonServer()
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRateLimit(0.1, 1),
+	)
+	if _, err := client.GetPokemon(context.Background(), "jolteon"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.GetPokemon(ctx, "jolteon")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded while throttled, got %v", err)
+	}
+}
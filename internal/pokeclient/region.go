@@ -0,0 +1,75 @@
+package pokeclient
+
+import (
+	"context"
+	"iter"
+)
+
+type Region struct {
+	ID             int     `json:"id"`
+	Name           string  `json:"name"`
+	MainGeneration Entry   `json:"main_generation"`
+	Locations      []Entry `json:"locations"`
+	VersionGroups  []Entry `json:"version_groups"`
+}
+
+// Location is a place in a region, such as a route or a city, made up of one
+// or more location areas.
+type Location struct {
+	ID     int            `json:"id"`
+	Name   string         `json:"name"`
+	Region *Entry         `json:"region"`
+	Areas  []LocationArea `json:"areas"`
+}
+
+func (c *Client) GetRegion(ctx context.Context, name string) (Region, error) {
+	return fetchResource[Region](ctx, c, ErrRegionNotFound, "region", name)
+}
+
+func (c *Client) GetLocation(ctx context.Context, name string) (Location, error) {
+	return fetchResource[Location](ctx, c, ErrLocationNotFound, "location", name)
+}
+
+// AllRegions iterates over every region.
+func (c *Client) AllRegions(ctx context.Context, opts ListOptions) iter.Seq2[Entry, error] {
+	return List[Entry](ctx, c, c.endpoint("region"), opts)
+}
+
+// GetRegionLocationAreas returns the location areas of every location in a
+// region, in the order PokeAPI lists the locations. The locations are fetched
+// as a batch.
+func (c *Client) GetRegionLocationAreas(ctx context.Context, name string) ([]LocationArea, error) {
+	region, err := c.GetRegion(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(region.Locations))
+	for i, location := range region.Locations {
+		names[i] = location.Name
+	}
+	var areas []LocationArea
+	for _, result := range Batch(ctx, c, names, c.GetLocation) {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		areas = append(areas, result.Value.Areas...)
+	}
+	return areas, nil
+}
+
+// GetLocationAreaRegion returns the name of the region a location area is
+// in, or an empty string for areas outside of any region.
+func (c *Client) GetLocationAreaRegion(ctx context.Context, area string) (string, error) {
+	locationArea, err := fetchResource[LocationAreaByNameResponse](ctx, c, ErrLocationAreaNotFound, "location-area", area)
+	if err != nil {
+		return "", err
+	}
+	location, err := c.GetLocation(ctx, locationArea.Location.Name)
+	if err != nil {
+		return "", err
+	}
+	if location.Region == nil {
+		return "", nil
+	}
+	return location.Region.Name, nil
+}
@@ -0,0 +1,412 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const (
+	kantoRegion     = `{"id": 1, "name": "kanto", "main_generation": {"name": "generation-i"}, "locations": [{"name": "pallet-town"}, {"name": "mt-moon"}]}`
+	palletTown      = `{"name": "pallet-town", "region": {"name": "kanto"}, "areas": []}`
+	mtMoon          = `{"name": "mt-moon", "region": {"name": "kanto"}, "areas": [{"name": "mt-moon-1f"}, {"name": "mt-moon-b1f"}]}`
+	distortionWorld = `{"name": "distortion-world", "region": null, "areas": [{"name": "distortion-world-area"}]}`
+)
+
+// serveResponses returns a handler that answers the paths in responses with
+// status and their body, and every other path with a 404.
+func serveResponses(status int, responses map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(response))
+	}
+}
+
+func TestGetRegion(t *testing.T) {
+	tests := []struct {
+		name               string
+		regionName         string
+		serverResponse     string
+		serverStatus       int
+		expectError        bool
+		expectNotFoundErr  bool
+		expectedGeneration string
+		expectedLocations  []string
+	}{
+		{
+			name:               "successful response",
+			regionName:         "kanto",
+			serverResponse:     kantoRegion,
+			serverStatus:       http.StatusOK,
+			expectedGeneration: "generation-i",
+			expectedLocations:  []string{"pallet-town", "mt-moon"},
+		},
+		{
+			name:              "region not found - 404",
+			regionName:        "orre",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			regionName:   "kanto",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			regionName:     "kanto",
+			serverResponse: `{"name": "kanto", "locations": {"name": "pallet-town"}}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			regionName:     "kanto",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			region, err := client.GetRegion(context.Background(), tt.regionName)
+
+			if requestedPath != "/region/"+tt.regionName {
+				t.Errorf("Expected request path /region/%s, got %s", tt.regionName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrRegionNotFound) {
+				t.Errorf("Expected ErrRegionNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if region.MainGeneration.Name != tt.expectedGeneration {
+					t.Errorf("Expected generation %s, got %s", tt.expectedGeneration, region.MainGeneration.Name)
+				}
+				var locations []string
+				for _, location := range region.Locations {
+					locations = append(locations, location.Name)
+				}
+				if !slices.Equal(locations, tt.expectedLocations) {
+					t.Errorf("Expected locations %v, got %v", tt.expectedLocations, locations)
+				}
+			}
+		})
+	}
+}
+
+func TestGetLocation(t *testing.T) {
+	tests := []struct {
+		name              string
+		locationName      string
+		serverResponse    string
+		serverStatus      int
+		expectError       bool
+		expectNotFoundErr bool
+		expectedRegion    string
+		expectedAreas     int
+	}{
+		{
+			name:           "successful response",
+			locationName:   "mt-moon",
+			serverResponse: mtMoon,
+			serverStatus:   http.StatusOK,
+			expectedRegion: "kanto",
+			expectedAreas:  2,
+		},
+		{
+			name:           "location outside of any region",
+			locationName:   "distortion-world",
+			serverResponse: distortionWorld,
+			serverStatus:   http.StatusOK,
+			expectedAreas:  1,
+		},
+		{
+			name:              "location not found - 404",
+			locationName:      "fake-town",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			locationName: "mt-moon",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			locationName:   "mt-moon",
+			serverResponse: `{"name": "mt-moon", "region": "kanto"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			locationName:   "mt-moon",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			location, err := client.GetLocation(context.Background(), tt.locationName)
+
+			if requestedPath != "/location/"+tt.locationName {
+				t.Errorf("Expected request path /location/%s, got %s", tt.locationName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrLocationNotFound) {
+				t.Errorf("Expected ErrLocationNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				region := ""
+				if location.Region != nil {
+					region = location.Region.Name
+				}
+				if region != tt.expectedRegion {
+					t.Errorf("Expected region %q, got %q", tt.expectedRegion, region)
+				}
+				if len(location.Areas) != tt.expectedAreas {
+					t.Errorf("Expected %d areas, got %d", tt.expectedAreas, len(location.Areas))
+				}
+			}
+		})
+	}
+}
+
+func TestAllRegions(t *testing.T) {
+	server := httptest.NewServer(serveResponses(http.StatusOK, map[string]string{
+		"/region": `{"count": 2, "next": null, "previous": null, "results": [{"name": "kanto"}, {"name": "johto"}]}`,
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	var names []string
+	for region, err := range client.AllRegions(context.Background(), pokeclient.ListOptions{}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		names = append(names, region.Name)
+	}
+	if !slices.Equal(names, []string{"kanto", "johto"}) {
+		t.Errorf("Expected [kanto johto], got %v", names)
+	}
+}
+
+func TestGetRegionLocationAreas(t *testing.T) {
+	tests := []struct {
+		name            string
+		regionName      string
+		serverResponses map[string]string
+		serverStatus    int
+		expectError     bool
+		expectedErr     error
+		expectedAreas   []string
+	}{
+		{
+			name:       "successful response",
+			regionName: "kanto",
+			serverResponses: map[string]string{
+				"/region/kanto":         kantoRegion,
+				"/location/pallet-town": palletTown,
+				"/location/mt-moon":     mtMoon,
+			},
+			serverStatus:  http.StatusOK,
+			expectedAreas: []string{"mt-moon-1f", "mt-moon-b1f"},
+		},
+		{
+			name:         "region not found - 404",
+			regionName:   "orre",
+			serverStatus: http.StatusNotFound,
+			expectError:  true,
+			expectedErr:  pokeclient.ErrRegionNotFound,
+		},
+		{
+			name:       "location not found - 404",
+			regionName: "kanto",
+			serverResponses: map[string]string{
+				"/region/kanto":         kantoRegion,
+				"/location/pallet-town": palletTown,
+			},
+			serverStatus: http.StatusOK,
+			expectError:  true,
+			expectedErr:  pokeclient.ErrLocationNotFound,
+		},
+		{
+			name:       "server error",
+			regionName: "kanto",
+			serverResponses: map[string]string{
+				"/region/kanto": kantoRegion,
+			},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:       "malformed location json",
+			regionName: "kanto",
+			serverResponses: map[string]string{
+				"/region/kanto":         kantoRegion,
+				"/location/pallet-town": palletTown,
+				"/location/mt-moon":     `{invalid json}`,
+			},
+			serverStatus: http.StatusOK,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(serveResponses(tt.serverStatus, tt.serverResponses))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			areas, err := client.GetRegionLocationAreas(context.Background(), tt.regionName)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Expected %v, got %v", tt.expectedErr, err)
+			}
+			if !tt.expectError {
+				var names []string
+				for _, area := range areas {
+					names = append(names, area.Name)
+				}
+				if !slices.Equal(names, tt.expectedAreas) {
+					t.Errorf("Expected areas %v, got %v", tt.expectedAreas, names)
+				}
+			}
+		})
+	}
+}
+
+func TestGetLocationAreaRegion(t *testing.T) {
+	tests := []struct {
+		name            string
+		areaName        string
+		serverResponses map[string]string
+		serverStatus    int
+		expectError     bool
+		expectedErr     error
+		expectedRegion  string
+	}{
+		{
+			name:     "area in a region",
+			areaName: "mt-moon-1f",
+			serverResponses: map[string]string{
+				"/location-area/mt-moon-1f": `{"name": "mt-moon-1f", "location": {"name": "mt-moon"}}`,
+				"/location/mt-moon":         mtMoon,
+			},
+			serverStatus:   http.StatusOK,
+			expectedRegion: "kanto",
+		},
+		{
+			name:     "area outside of any region",
+			areaName: "distortion-world-area",
+			serverResponses: map[string]string{
+				"/location-area/distortion-world-area": `{"name": "distortion-world-area", "location": {"name": "distortion-world"}}`,
+				"/location/distortion-world":           distortionWorld,
+			},
+			serverStatus: http.StatusOK,
+		},
+		{
+			name:         "area not found - 404",
+			areaName:     "nowhere",
+			serverStatus: http.StatusNotFound,
+			expectError:  true,
+			expectedErr:  pokeclient.ErrLocationAreaNotFound,
+		},
+		{
+			name:     "server error",
+			areaName: "mt-moon-1f",
+			serverResponses: map[string]string{
+				"/location-area/mt-moon-1f": `{"name": "mt-moon-1f", "location": {"name": "mt-moon"}}`,
+			},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:     "malformed location json",
+			areaName: "mt-moon-1f",
+			serverResponses: map[string]string{
+				"/location-area/mt-moon-1f": `{"name": "mt-moon-1f", "location": {"name": "mt-moon"}}`,
+				"/location/mt-moon":         `{invalid json}`,
+			},
+			serverStatus: http.StatusOK,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(serveResponses(tt.serverStatus, tt.serverResponses))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			region, err := client.GetLocationAreaRegion(context.Background(), tt.areaName)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Expected %v, got %v", tt.expectedErr, err)
+			}
+			if !tt.expectError && region != tt.expectedRegion {
+				t.Errorf("Expected region %q, got %q", tt.expectedRegion, region)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package pokeclient_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+var fastRetries = pokeclient.RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    10 * time.Millisecond,
+}
+
+// flakyServer fails the first failures requests with status and then serves
+// a valid pokemon.
+func flakyServer(failures int32, status int, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "porygon", "base_experience": 79}`))
+	}))
+	return server, &calls
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls := flakyServer(2, status, "")
+			defer server.Close()
+
+			client := pokeclient.NewClient(
+				pokeclient.WithBaseURL(server.URL),
+				pokeclient.WithRetryPolicy(fastRetries),
+			)
+			result, err := client.GetPokemon(context.Background(), "porygon")
+			if err != nil {
+				t.Fatalf("Expected retries to succeed, got %v", err)
+			}
+			if result.Name != "porygon" {
+				t.Errorf("Expected name 'porygon', got %s", result.Name)
+			}
+			if calls.Load() != 3 {
+				t.Errorf("Expected 3 server calls, got %d", calls.Load())
+			}
+			if retries := client.Stats().Retries; retries != 2 {
+				t.Errorf("Expected 2 retries in stats, got %d", retries)
+			}
+		})
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	server, calls := flakyServer(10, http.StatusServiceUnavailable, "")
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRetryPolicy(fastRetries),
+	)
+	if _, err := client.GetPokemon(context.Background(), "porygon"); err == nil {
+		t.Error("Expected error after exhausting retries")
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 server calls, got %d", calls.Load())
+	}
+}
+
+func TestRetrySkipsPermanentErrors(t *testing.T) {
+	server, calls := flakyServer(10, http.StatusNotFound, "")
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRetryPolicy(fastRetries),
+	)
+	client.GetPokemon(context.Background(), "porygon")
+	if calls.Load() != 1 {
+		t.Errorf("Expected 404 not to be retried, got %d calls", calls.Load())
+	}
+}
+
+func TestRetryBackoffWithoutMaxDelay(t *testing.T) {
+	server, calls := flakyServer(2, http.StatusServiceUnavailable, "")
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRetryPolicy(pokeclient.RetryPolicy{MaxAttempts: 3, BaseDelay: 40 * time.Millisecond}),
+	)
+
+	start := time.Now()
+	if _, err := client.GetPokemon(context.Background(), "porygon"); err != nil {
+		t.Fatalf("Expected retries to succeed, got %v", err)
+	}
+	// At least half of each delay is fixed: 20ms, then 40ms.
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("Expected retries to back off without a max delay, only waited %v", elapsed)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 server calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	server, calls := flakyServer(1, http.StatusTooManyRequests, "1")
+	defer server.Close()
+
+	policy := fastRetries
+	policy.MaxDelay = 2 * time.Second
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRetryPolicy(policy),
+	)
+
+	start := time.Now()
+	if _, err := client.GetPokemon(context.Background(), "porygon"); err != nil {
+		t.Fatalf("Expected retry to succeed, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected to wait for Retry-After, only waited %v", elapsed)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected 2 server calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryAfterBeyondMaxDelay(t *testing.T) {
+	server, calls := flakyServer(1, http.StatusTooManyRequests, "3600")
+	defer server.Close()
+
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRetryPolicy(fastRetries),
+	)
+	if _, err := client.GetPokemon(context.Background(), "porygon"); err == nil {
+		t.Error("Expected error when Retry-After exceeds the max delay")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected no retry, got %d calls", calls.Load())
+	}
+}
+
+func TestRetryPerAttemptTimeout(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "porygon", "base_experience": 79}`))
+	}))
+	defer server.Close()
+
+	policy := fastRetries
+	policy.PerAttemptTimeout = 50 * time.Millisecond
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRetryPolicy(policy),
+	)
+	if _, err := client.GetPokemon(context.Background(), "porygon"); err != nil {
+		t.Fatalf("Expected second attempt to succeed, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected 2 server calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryDebugOutput(t *testing.T) {
+	server, _ := flakyServer(2, http.StatusBadGateway, "")
+	defer server.Close()
+
+	var out bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := pokeclient.NewClient(
+		pokeclient.WithBaseURL(server.URL),
+		pokeclient.WithRetryPolicy(fastRetries),
+		pokeclient.WithLogger(logger),
+	)
+	if _, err := client.GetPokemon(context.Background(), "porygon"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	logs := out.String()
+	if strings.Count(logs, "retrying request") != 2 {
+		t.Errorf("Expected 2 retry log lines, got:\n%s", logs)
+	}
+	if !strings.Contains(logs, "attempts=3") {
+		t.Errorf("Expected final attempt count in debug output, got:\n%s", logs)
+	}
+}
@@ -0,0 +1,188 @@
+package pokeclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestRevalidateWithETag(t *testing.T) {
+	var ifNoneMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "gengar", "base_experience": 250}`))
+	}))
+	defer server.Close()
+
+	interval := 50 * time.Millisecond
+	cache := pokecache.NewCache(interval, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+	if _, err := client.GetPokemon(context.Background(), "gengar"); err != nil {
+		t.Fatalf("First call failed: %v", err)
+	}
+
+	// Let the entry expire so the next call has to revalidate it.
+	time.Sleep(interval + 20*time.Millisecond)
+
+	result, err := client.GetPokemon(context.Background(), "gengar")
+	if err != nil {
+		t.Fatalf("Revalidating call failed: %v", err)
+	}
+	if result.Name != "gengar" || result.BaseExperience != 250 {
+		t.Errorf("Expected cached gengar to be returned, got %+v", result)
+	}
+
+	// The refreshed entry is fresh again and served without a request.
+	if _, err := client.GetPokemon(context.Background(), "gengar"); err != nil {
+		t.Fatalf("Third call failed: %v", err)
+	}
+
+	if len(ifNoneMatch) != 2 {
+		t.Fatalf("Expected 2 server calls, got %d", len(ifNoneMatch))
+	}
+	if ifNoneMatch[0] != "" {
+		t.Errorf("Expected first request to be unconditional, got If-None-Match %s", ifNoneMatch[0])
+	}
+	if ifNoneMatch[1] != `"v1"` {
+		t.Errorf("Expected revalidation with If-None-Match \"v1\", got %q", ifNoneMatch[1])
+	}
+	if revalidated := client.Stats().Revalidated; revalidated != 1 {
+		t.Errorf("Expected 1 revalidated response in stats, got %d", revalidated)
+	}
+}
+
+func TestRevalidateWithLastModified(t *testing.T) {
+	const lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
+	var ifModifiedSince []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifModifiedSince = append(ifModifiedSince, r.Header.Get("If-Modified-Since"))
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Last-Modified", lastModified)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"pokemon_encounters": [{"pokemon": {"name": "zubat"}}]}`))
+	}))
+	defer server.Close()
+
+	interval := 50 * time.Millisecond
+	cache := pokecache.NewCache(interval, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+	if _, err := client.GetPokemonForLocationName(context.Background(), "mt-moon-1f"); err != nil {
+		t.Fatalf("First call failed: %v", err)
+	}
+	time.Sleep(interval + 20*time.Millisecond)
+
+	result, err := client.GetPokemonForLocationName(context.Background(), "mt-moon-1f")
+	if err != nil {
+		t.Fatalf("Revalidating call failed: %v", err)
+	}
+	if len(result) != 1 || result[0] != "zubat" {
+		t.Errorf("Expected cached [zubat], got %v", result)
+	}
+	if len(ifModifiedSince) != 2 || ifModifiedSince[1] != lastModified {
+		t.Errorf("Expected revalidation with If-Modified-Since, got %v", ifModifiedSince)
+	}
+}
+
+func TestRevalidateKeepsNewValidators(t *testing.T) {
+	var ifNoneMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") != "" {
+			// The 304 names the cached copy by a new ETag.
+			w.Header().Set("ETag", `"v2"`)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "haunter", "base_experience": 142}`))
+	}))
+	defer server.Close()
+
+	interval := 50 * time.Millisecond
+	cache := pokecache.NewCache(interval, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+	for range 3 {
+		result, err := client.GetPokemon(context.Background(), "haunter")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Name != "haunter" {
+			t.Errorf("Expected cached haunter, got %+v", result)
+		}
+		time.Sleep(interval + 20*time.Millisecond)
+	}
+
+	expected := []string{"", `"v1"`, `"v2"`}
+	if len(ifNoneMatch) != len(expected) {
+		t.Fatalf("Expected %d server calls, got %v", len(expected), ifNoneMatch)
+	}
+	for i := range expected {
+		if ifNoneMatch[i] != expected[i] {
+			t.Errorf("Request %d: expected If-None-Match %q, got %q", i, expected[i], ifNoneMatch[i])
+		}
+	}
+}
+
+func TestRevalidateChangedResource(t *testing.T) {
+	version := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if version == 1 {
+			w.Header().Set("ETag", `"v1"`)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "eevee", "base_experience": 65}`))
+			return
+		}
+		w.Header().Set("ETag", `"v2"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "eevee", "base_experience": 66}`))
+	}))
+	defer server.Close()
+
+	interval := 50 * time.Millisecond
+	cache := pokecache.NewCache(interval, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL), pokeclient.WithCache(cache))
+
+	if _, err := client.GetPokemon(context.Background(), "eevee"); err != nil {
+		t.Fatalf("First call failed: %v", err)
+	}
+	// The resource changed, so the old ETag no longer matches.
+	version = 2
+	time.Sleep(interval + 20*time.Millisecond)
+
+	result, err := client.GetPokemon(context.Background(), "eevee")
+	if err != nil {
+		t.Fatalf("Second call failed: %v", err)
+	}
+	if result.BaseExperience != 66 {
+		t.Errorf("Expected updated base experience 66, got %d", result.BaseExperience)
+	}
+	if _, validators, _ := cache.GetStale(server.URL + "/pokemon/eevee"); validators.ETag != `"v2"` {
+		t.Errorf("Expected cache to hold the new ETag, got %q", validators.ETag)
+	}
+}
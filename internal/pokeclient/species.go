@@ -0,0 +1,102 @@
+package pokeclient
+
+import (
+	"context"
+	"strings"
+)
+
+type Genus struct {
+	Genus    string `json:"genus"`
+	Language Entry  `json:"language"`
+}
+
+type FlavorText struct {
+	FlavorText string `json:"flavor_text"`
+	Language   Entry  `json:"language"`
+	Version    Entry  `json:"version"`
+}
+
+// PokemonSpeciesVariety is one of the pokemon, such as a regional form, that
+// belong to a species.
+type PokemonSpeciesVariety struct {
+	IsDefault bool  `json:"is_default"`
+	Pokemon   Entry `json:"pokemon"`
+}
+
+type PokemonSpecies struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	CaptureRate   int    `json:"capture_rate"`
+	BaseHappiness *int   `json:"base_happiness"`
+	// GenderRate is the chance of being female in eighths, or -1 for
+	// genderless species.
+	GenderRate        int                     `json:"gender_rate"`
+	IsBaby            bool                    `json:"is_baby"`
+	IsLegendary       bool                    `json:"is_legendary"`
+	IsMythical        bool                    `json:"is_mythical"`
+	Genera            []Genus                 `json:"genera"`
+	FlavorTextEntries []FlavorText            `json:"flavor_text_entries"`
+	GrowthRate        Entry                   `json:"growth_rate"`
+	EggGroups         []Entry                 `json:"egg_groups"`
+	EvolutionChain    *APIResource            `json:"evolution_chain"`
+	Names             []Name                  `json:"names"`
+	Varieties         []PokemonSpeciesVariety `json:"varieties"`
+}
+
+// LocalizedName returns the name of the species in the given language,
+// falling back to Name.
+func (s PokemonSpecies) LocalizedName(language string) string {
+	return localizedName(s.Names, language, s.Name)
+}
+
+// DefaultPokemon returns the name of the default pokemon of the species,
+// which differs from the species name for species such as deoxys.
+func (s PokemonSpecies) DefaultPokemon() string {
+	for _, variety := range s.Varieties {
+		if variety.IsDefault {
+			return variety.Pokemon.Name
+		}
+	}
+	return s.Name
+}
+
+// Genus returns the genus in the given language, such as "Mouse Pokémon" for
+// pikachu in "en", or an empty string.
+func (s PokemonSpecies) Genus(language string) string {
+	for _, g := range s.Genera {
+		if g.Language.Name == language {
+			return g.Genus
+		}
+	}
+	return ""
+}
+
+// FlavorText returns the first pokedex entry in the given language, with the
+// line breaks of the games' text boxes collapsed into spaces.
+func (s PokemonSpecies) FlavorText(language string) string {
+	for _, entry := range s.FlavorTextEntries {
+		if entry.Language.Name == language {
+			return cleanFlavorText(entry.FlavorText)
+		}
+	}
+	return ""
+}
+
+// VersionFlavorText returns the pokedex entry of a game version in the given
+// language, or an empty string when that version has none.
+func (s PokemonSpecies) VersionFlavorText(language, version string) string {
+	for _, entry := range s.FlavorTextEntries {
+		if entry.Language.Name == language && entry.Version.Name == version {
+			return cleanFlavorText(entry.FlavorText)
+		}
+	}
+	return ""
+}
+
+func cleanFlavorText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func (c *Client) GetPokemonSpecies(ctx context.Context, name string) (PokemonSpecies, error) {
+	return fetchResource[PokemonSpecies](ctx, c, ErrSpeciesNotFound, "pokemon-species", name)
+}
@@ -0,0 +1,176 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+const pikachuSpecies = `{
+	"id": 25,
+	"name": "pikachu",
+	"capture_rate": 190,
+	"base_happiness": 50,
+	"gender_rate": 4,
+	"is_baby": false,
+	"is_legendary": false,
+	"is_mythical": false,
+	"genera": [
+		{"genus": "ねずみポケモン", "language": {"name": "ja"}},
+		{"genus": "Mouse Pokémon", "language": {"name": "en"}}
+	],
+	"flavor_text_entries": [
+		{"flavor_text": "ほっぺたの 両側に\nちいさい 電気袋を 持つ。", "language": {"name": "ja"}, "version": {"name": "x"}},
+		{"flavor_text": "When several of\nthese POKéMON\fgather, their\nelectricity could\nbuild and cause\nlightning storms.", "language": {"name": "en"}, "version": {"name": "red"}}
+	],
+	"growth_rate": {"name": "medium", "url": "https://pokeapi.co/api/v2/growth-rate/2/"},
+	"egg_groups": [
+		{"name": "ground", "url": "https://pokeapi.co/api/v2/egg-group/5/"},
+		{"name": "fairy", "url": "https://pokeapi.co/api/v2/egg-group/6/"}
+	]
+}`
+
+func TestGetPokemonSpecies(t *testing.T) {
+	tests := []struct {
+		name                string
+		speciesName         string
+		serverResponse      string
+		serverStatus        int
+		expectError         bool
+		expectNotFoundErr   bool
+		expectedID          int
+		expectedCaptureRate int
+		expectedGrowthRate  string
+	}{
+		{
+			name:                "successful response",
+			speciesName:         "pikachu",
+			serverResponse:      pikachuSpecies,
+			serverStatus:        http.StatusOK,
+			expectedID:          25,
+			expectedCaptureRate: 190,
+			expectedGrowthRate:  "medium",
+		},
+		{
+			name:                "species without optional fields",
+			speciesName:         "kubfu",
+			serverResponse:      `{"id": 891, "name": "kubfu", "capture_rate": 3, "base_happiness": null, "growth_rate": {"name": "slow"}}`,
+			serverStatus:        http.StatusOK,
+			expectedID:          891,
+			expectedCaptureRate: 3,
+			expectedGrowthRate:  "slow",
+		},
+		{
+			name:              "species not found - 404",
+			speciesName:       "fakemon",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			speciesName:  "pikachu",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			speciesName:    "pikachu",
+			serverResponse: `{"name": "pikachu", "capture_rate": "high"}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			speciesName:    "pikachu",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			species, err := client.GetPokemonSpecies(context.Background(), tt.speciesName)
+
+			if requestedPath != "/pokemon-species/"+tt.speciesName {
+				t.Errorf("Expected request path /pokemon-species/%s, got %s", tt.speciesName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrSpeciesNotFound) {
+				t.Errorf("Expected ErrSpeciesNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if species.ID != tt.expectedID || species.Name != tt.speciesName {
+					t.Errorf("Expected %s (%d), got %s (%d)", tt.speciesName, tt.expectedID, species.Name, species.ID)
+				}
+				if species.CaptureRate != tt.expectedCaptureRate {
+					t.Errorf("Expected capture rate %d, got %d", tt.expectedCaptureRate, species.CaptureRate)
+				}
+				if species.GrowthRate.Name != tt.expectedGrowthRate {
+					t.Errorf("Expected growth rate %s, got %s", tt.expectedGrowthRate, species.GrowthRate.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestPokemonSpeciesText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(pikachuSpecies))
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	species, err := client.GetPokemonSpecies(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if species.BaseHappiness == nil || *species.BaseHappiness != 50 {
+		t.Errorf("Expected base happiness 50, got %v", species.BaseHappiness)
+	}
+	if species.GenderRate != 4 {
+		t.Errorf("Expected gender rate 4, got %d", species.GenderRate)
+	}
+	if len(species.EggGroups) != 2 || species.EggGroups[0].Name != "ground" {
+		t.Errorf("Expected egg groups [ground fairy], got %v", species.EggGroups)
+	}
+	if genus := species.Genus("en"); genus != "Mouse Pokémon" {
+		t.Errorf("Expected genus 'Mouse Pokémon', got %q", genus)
+	}
+	if genus := species.Genus("fr"); genus != "" {
+		t.Errorf("Expected no genus for a missing language, got %q", genus)
+	}
+	expected := "When several of these POKéMON gather, their electricity could build and cause lightning storms."
+	if text := species.FlavorText("en"); text != expected {
+		t.Errorf("Expected flavor text %q, got %q", expected, text)
+	}
+	if text := species.VersionFlavorText("en", "red"); text != expected {
+		t.Errorf("Expected red flavor text %q, got %q", expected, text)
+	}
+	if text := species.VersionFlavorText("en", "x"); text != "" {
+		t.Errorf("Expected no English flavor text in x, got %q", text)
+	}
+}
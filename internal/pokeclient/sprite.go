@@ -0,0 +1,47 @@
+package pokeclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+)
+
+var ErrNoSprite = errors.New("sprite not found")
+
+// Sprites links to the PNG images of a pokemon. Links are empty when the
+// pokemon has no such sprite.
+type Sprites struct {
+	FrontDefault string `json:"front_default"`
+	FrontShiny   string `json:"front_shiny"`
+	BackDefault  string `json:"back_default"`
+	BackShiny    string `json:"back_shiny"`
+}
+
+// GetSprite downloads and decodes the PNG sprite at url, such as
+// Sprites.FrontDefault. Sprites are cached like other responses. An empty url
+// or a missing image is reported as ErrNoSprite.
+func (c *Client) GetSprite(ctx context.Context, url string) (image.Image, error) {
+	if url == "" {
+		return nil, ErrNoSprite
+	}
+	d, err := c.fetchBody(ctx, url, ErrNoSprite, pngFormat, func(body []byte) error {
+		_, err := png.DecodeConfig(bytes.NewReader(body))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	img, err := png.Decode(bytes.NewReader(d))
+	if err != nil {
+		return nil, &DecodeError{URL: url, Err: err}
+	}
+	return img, nil
+}
+
+var pngFormat = responseFormat{accept: "image/png", matches: isPNG}
+
+func isPNG(mediaType string) bool {
+	return mediaType == "image/png"
+}
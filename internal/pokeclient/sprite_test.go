@@ -0,0 +1,92 @@
+package pokeclient_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jabreu610/pokedexcli/internal/pokecache"
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func newSpriteServer(t *testing.T, calls *int) *httptest.Server {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	var sprite bytes.Buffer
+	if err := png.Encode(&sprite, img); err != nil {
+		t.Fatal(err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		switch r.URL.Path {
+		case "/sprites/25.png":
+			// Negotiate the content type like a mirror might.
+			if r.Header.Get("Accept") != "image/png" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			w.Header().Set("Content-Type", "image/png")
+			w.WriteHeader(http.StatusOK)
+			w.Write(sprite.Bytes())
+		case "/sprites/broken.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("not a png"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestGetSprite(t *testing.T) {
+	calls := 0
+	server := newSpriteServer(t, &calls)
+	defer server.Close()
+
+	cache := pokecache.NewCache(5*time.Second, context.Background())
+	defer cache.Close()
+	client := pokeclient.NewClient(pokeclient.WithCache(cache))
+
+	for range 2 {
+		img, err := client.GetSprite(context.Background(), server.URL+"/sprites/25.png")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if img.Bounds().Dx() != 2 {
+			t.Errorf("Expected a 2 pixel wide sprite, got %d", img.Bounds().Dx())
+		}
+		if r, _, _, _ := img.At(0, 0).RGBA(); r != 0xffff {
+			t.Errorf("Expected a red top left pixel, got %v", img.At(0, 0))
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the sprite to be cached (1 server call), got %d calls", calls)
+	}
+}
+
+func TestGetSpriteErrors(t *testing.T) {
+	calls := 0
+	server := newSpriteServer(t, &calls)
+	defer server.Close()
+
+	client := pokeclient.NewClient()
+
+	if _, err := client.GetSprite(context.Background(), ""); !errors.Is(err, pokeclient.ErrNoSprite) {
+		t.Errorf("Expected ErrNoSprite for an empty url, got %v", err)
+	}
+	if _, err := client.GetSprite(context.Background(), server.URL+"/sprites/0.png"); !errors.Is(err, pokeclient.ErrNoSprite) {
+		t.Errorf("Expected ErrNoSprite for a missing sprite, got %v", err)
+	}
+	var decodeErr *pokeclient.DecodeError
+	if _, err := client.GetSprite(context.Background(), server.URL+"/sprites/broken.png"); !errors.As(err, &decodeErr) {
+		t.Errorf("Expected *DecodeError for a broken sprite, got %v", err)
+	}
+}
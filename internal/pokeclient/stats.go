@@ -0,0 +1,45 @@
+package pokeclient
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Stats counts the work a Client has done since it was created.
+type Stats struct {
+	// Requests is the number of HTTP requests sent, including retries.
+	Requests int64
+	Retries  int64
+	// Throttled is the number of requests the rate limiter delayed, and
+	// ThrottleWait the total time they spent waiting.
+	Throttled    int64
+	ThrottleWait time.Duration
+	// Deduplicated is the number of calls that shared the request of a
+	// concurrent call for the same resource instead of sending their own.
+	Deduplicated int64
+	// Revalidated is the number of expired cache entries the server confirmed
+	// unchanged with a 304 Not Modified.
+	Revalidated int64
+}
+
+type clientStats struct {
+	requests     atomic.Int64
+	retries      atomic.Int64
+	throttled    atomic.Int64
+	throttleWait atomic.Int64
+	deduplicated atomic.Int64
+	revalidated  atomic.Int64
+}
+
+// Stats returns a snapshot of the client's counters. It is safe to call while
+// requests are in flight.
+func (c *Client) Stats() Stats {
+	return Stats{
+		Requests:     c.stats.requests.Load(),
+		Retries:      c.stats.retries.Load(),
+		Throttled:    c.stats.throttled.Load(),
+		ThrottleWait: time.Duration(c.stats.throttleWait.Load()),
+		Deduplicated: c.stats.deduplicated.Load(),
+		Revalidated:  c.stats.revalidated.Load(),
+	}
+}
@@ -0,0 +1,129 @@
+package pokeclient
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// DamageRelations lists the types a type is strong or weak against, when
+// attacking and when defending.
+type DamageRelations struct {
+	DoubleDamageFrom []Entry `json:"double_damage_from"`
+	DoubleDamageTo   []Entry `json:"double_damage_to"`
+	HalfDamageFrom   []Entry `json:"half_damage_from"`
+	HalfDamageTo     []Entry `json:"half_damage_to"`
+	NoDamageFrom     []Entry `json:"no_damage_from"`
+	NoDamageTo       []Entry `json:"no_damage_to"`
+}
+
+func (r DamageRelations) empty() bool {
+	return len(r.DoubleDamageFrom)+len(r.DoubleDamageTo)+len(r.HalfDamageFrom)+
+		len(r.HalfDamageTo)+len(r.NoDamageFrom)+len(r.NoDamageTo) == 0
+}
+
+// TypeDetails is the type resource, as opposed to Type, which only names the
+// type of a pokemon.
+type TypeDetails struct {
+	ID              int             `json:"id"`
+	Name            string          `json:"name"`
+	DamageRelations DamageRelations `json:"damage_relations"`
+}
+
+func (c *Client) GetType(ctx context.Context, name string) (TypeDetails, error) {
+	return fetchResource[TypeDetails](ctx, c, ErrTypeNotFound, "type", name)
+}
+
+// TypeChart holds the damage multiplier of every attacking type against every
+// defending type.
+type TypeChart struct {
+	types       []string
+	multipliers map[string]map[string]float64
+}
+
+// NewTypeChart builds a chart from the attacking side of each type's damage
+// relations. Types without any damage relations, such as unknown and shadow,
+// are left out.
+func NewTypeChart(types []TypeDetails) *TypeChart {
+	chart := &TypeChart{multipliers: map[string]map[string]float64{}}
+	for _, t := range types {
+		if t.DamageRelations.empty() {
+			continue
+		}
+		chart.types = append(chart.types, t.Name)
+		multipliers := map[string]float64{}
+		for _, defending := range t.DamageRelations.DoubleDamageTo {
+			multipliers[defending.Name] = 2
+		}
+		for _, defending := range t.DamageRelations.HalfDamageTo {
+			multipliers[defending.Name] = 0.5
+		}
+		for _, defending := range t.DamageRelations.NoDamageTo {
+			multipliers[defending.Name] = 0
+		}
+		chart.multipliers[t.Name] = multipliers
+	}
+	slices.Sort(chart.types)
+	return chart
+}
+
+// Types returns the names of the types in the chart in alphabetical order.
+func (t *TypeChart) Types() []string {
+	return slices.Clone(t.types)
+}
+
+// Effectiveness returns the damage multiplier of an attacking type against a
+// pokemon of the defending types. The multipliers of dual types are combined,
+// so the result is one of 4, 2, 1, 0.5, 0.25 or 0.
+func (t *TypeChart) Effectiveness(attacking string, defending ...string) float64 {
+	multiplier := 1.0
+	for _, d := range defending {
+		if m, ok := t.multipliers[attacking][d]; ok {
+			multiplier *= m
+		}
+	}
+	return multiplier
+}
+
+// Defending returns the multiplier of every attacking type in the chart
+// against a pokemon of the defending types.
+func (t *TypeChart) Defending(defending ...string) map[string]float64 {
+	multipliers := make(map[string]float64, len(t.types))
+	for _, attacking := range t.types {
+		multipliers[attacking] = t.Effectiveness(attacking, defending...)
+	}
+	return multipliers
+}
+
+type typeChartCache struct {
+	mu    sync.Mutex
+	chart *TypeChart
+}
+
+// GetTypeChart fetches every type and builds the type chart. The chart is
+// kept for the life of the client, since type matchups only change between
+// generations.
+func (c *Client) GetTypeChart(ctx context.Context) (*TypeChart, error) {
+	c.typeChart.mu.Lock()
+	defer c.typeChart.mu.Unlock()
+	if c.typeChart.chart != nil {
+		return c.typeChart.chart, nil
+	}
+
+	var names []string
+	for entry, err := range List[Entry](ctx, c, c.endpoint("type"), ListOptions{PageSize: 100}) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, entry.Name)
+	}
+	var types []TypeDetails
+	for _, result := range Batch(ctx, c, names, c.GetType) {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		types = append(types, result.Value)
+	}
+	c.typeChart.chart = NewTypeChart(types)
+	return c.typeChart.chart, nil
+}
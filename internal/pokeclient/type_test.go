@@ -0,0 +1,186 @@
+package pokeclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+var testTypes = map[string]string{
+	"electric": `{"double_damage_to": [{"name": "water"}, {"name": "flying"}], "half_damage_to": [{"name": "grass"}, {"name": "electric"}], "no_damage_to": [{"name": "ground"}]}`,
+	"ground":   `{"double_damage_to": [{"name": "fire"}, {"name": "electric"}], "half_damage_to": [{"name": "grass"}], "no_damage_to": [{"name": "flying"}]}`,
+	"grass":    `{"double_damage_to": [{"name": "water"}, {"name": "ground"}], "half_damage_to": [{"name": "fire"}, {"name": "flying"}, {"name": "grass"}]}`,
+	"fire":     `{"double_damage_to": [{"name": "grass"}], "half_damage_to": [{"name": "fire"}, {"name": "water"}]}`,
+	"water":    `{"double_damage_to": [{"name": "fire"}, {"name": "ground"}], "half_damage_to": [{"name": "water"}, {"name": "grass"}]}`,
+	"flying":   `{"double_damage_to": [{"name": "grass"}], "half_damage_to": [{"name": "electric"}]}`,
+	"unknown":  `{}`,
+}
+
+func TestGetType(t *testing.T) {
+	tests := []struct {
+		name               string
+		typeName           string
+		serverResponse     string
+		serverStatus       int
+		expectError        bool
+		expectNotFoundErr  bool
+		expectedNoDamageTo []string
+	}{
+		{
+			name:               "successful response",
+			typeName:           "electric",
+			serverResponse:     `{"name": "electric", "damage_relations": ` + testTypes["electric"] + `}`,
+			serverStatus:       http.StatusOK,
+			expectedNoDamageTo: []string{"ground"},
+		},
+		{
+			name:           "type without damage relations",
+			typeName:       "unknown",
+			serverResponse: `{"name": "unknown", "damage_relations": {}}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			name:              "type not found - 404",
+			typeName:          "sound",
+			serverStatus:      http.StatusNotFound,
+			expectError:       true,
+			expectNotFoundErr: true,
+		},
+		{
+			name:         "server error",
+			typeName:     "electric",
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "invalid json",
+			typeName:       "electric",
+			serverResponse: `{"name": "electric", "damage_relations": []}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:           "malformed json",
+			typeName:       "electric",
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath := ""
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestedPath = r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+			typ, err := client.GetType(context.Background(), tt.typeName)
+
+			if requestedPath != "/type/"+tt.typeName {
+				t.Errorf("Expected request path /type/%s, got %s", tt.typeName, requestedPath)
+			}
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectNotFoundErr && !errors.Is(err, pokeclient.ErrTypeNotFound) {
+				t.Errorf("Expected ErrTypeNotFound, got %v", err)
+			}
+			if !tt.expectError {
+				if typ.Name != tt.typeName {
+					t.Errorf("Expected type %s, got %s", tt.typeName, typ.Name)
+				}
+				var noDamageTo []string
+				for _, entry := range typ.DamageRelations.NoDamageTo {
+					noDamageTo = append(noDamageTo, entry.Name)
+				}
+				if !slices.Equal(noDamageTo, tt.expectedNoDamageTo) {
+					t.Errorf("Expected %s to have no effect on %v, got %v", tt.typeName, tt.expectedNoDamageTo, noDamageTo)
+				}
+			}
+		})
+	}
+}
+
+func TestTypeChart(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/type" {
+			var results []string
+			for name := range testTypes {
+				results = append(results, fmt.Sprintf(`{"name": %q}`, name))
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"count": %d, "next": null, "results": [%s]}`, len(results), strings.Join(results, ","))
+			return
+		}
+		name := strings.TrimPrefix(r.URL.Path, "/type/")
+		relations, ok := testTypes[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"name": %q, "damage_relations": %s}`, name, relations)
+	}))
+	defer server.Close()
+
+	client := pokeclient.NewClient(pokeclient.WithBaseURL(server.URL))
+	chart, err := client.GetTypeChart(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if types := chart.Types(); !slices.Equal(types, []string{"electric", "fire", "flying", "grass", "ground", "water"}) {
+		t.Errorf("Expected types without damage relations to be left out, got %v", types)
+	}
+
+	tests := []struct {
+		attacking string
+		defending []string
+		expected  float64
+	}{
+		{attacking: "electric", defending: []string{"water"}, expected: 2},
+		{attacking: "electric", defending: []string{"water", "flying"}, expected: 4},
+		{attacking: "electric", defending: []string{"fire"}, expected: 1},
+		{attacking: "grass", defending: []string{"fire", "flying"}, expected: 0.25},
+		{attacking: "ground", defending: []string{"fire", "flying"}, expected: 0},
+		{attacking: "water", defending: []string{"fire", "grass"}, expected: 1},
+	}
+	for _, tt := range tests {
+		if got := chart.Effectiveness(tt.attacking, tt.defending...); got != tt.expected {
+			t.Errorf("%s against %v: expected %v, got %v", tt.attacking, tt.defending, tt.expected, got)
+		}
+	}
+
+	defending := chart.Defending("water", "ground")
+	if defending["grass"] != 4 || defending["electric"] != 0 || defending["fire"] != 0.5 {
+		t.Errorf("Unexpected multipliers against water/ground: %v", defending)
+	}
+
+	before := requests.Load()
+	if _, err := client.GetTypeChart(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests.Load() != before {
+		t.Errorf("Expected the chart to be reused, got %d more requests", requests.Load()-before)
+	}
+}
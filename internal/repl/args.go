@@ -0,0 +1,49 @@
+package repl
+
+import (
+	"slices"
+	"strings"
+)
+
+// Args is a command's arguments split into positional arguments and flags.
+type Args struct {
+	Positional []string
+	Flags      map[string]string
+}
+
+// ParseArgs separates "--name value" and "--name=value" flags from positional
+// arguments. Flags named in boolFlags never take a value, so "--name" alone
+// is enough to set them.
+func ParseArgs(args []string, boolFlags ...string) Args {
+	out := Args{Flags: map[string]string{}}
+	for i := 0; i < len(args); i++ {
+		name, ok := strings.CutPrefix(args[i], "--")
+		if !ok || name == "" {
+			out.Positional = append(out.Positional, args[i])
+			continue
+		}
+		if name, value, ok := strings.Cut(name, "="); ok {
+			out.Flags[name] = value
+			continue
+		}
+		if slices.Contains(boolFlags, name) || i+1 == len(args) || strings.HasPrefix(args[i+1], "--") {
+			out.Flags[name] = ""
+			continue
+		}
+		out.Flags[name] = args[i+1]
+		i++
+	}
+	return out
+}
+
+// Flag returns the value of the named flag and whether it was given.
+func (a Args) Flag(name string) (string, bool) {
+	value, ok := a.Flags[name]
+	return value, ok
+}
+
+// Has reports whether the named flag was given.
+func (a Args) Has(name string) bool {
+	_, ok := a.Flags[name]
+	return ok
+}
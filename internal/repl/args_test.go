@@ -0,0 +1,75 @@
+package repl_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/repl"
+)
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		input              []string
+		boolFlags          []string
+		expectedPositional []string
+		expectedFlags      map[string]string
+	}{
+		{
+			input:              []string{"pikachu"},
+			expectedPositional: []string{"pikachu"},
+			expectedFlags:      map[string]string{},
+		},
+		{
+			input:              []string{"pikachu", "--version", "red-blue", "--method", "level-up"},
+			expectedPositional: []string{"pikachu"},
+			expectedFlags:      map[string]string{"version": "red-blue", "method": "level-up"},
+		},
+		{
+			input:              []string{"--version=red-blue", "pikachu"},
+			expectedPositional: []string{"pikachu"},
+			expectedFlags:      map[string]string{"version": "red-blue"},
+		},
+		{
+			input:              []string{"--sprite", "pikachu"},
+			boolFlags:          []string{"sprite"},
+			expectedPositional: []string{"pikachu"},
+			expectedFlags:      map[string]string{"sprite": ""},
+		},
+		{
+			input:              []string{"pikachu", "--details", "--version", "red"},
+			expectedPositional: []string{"pikachu"},
+			expectedFlags:      map[string]string{"details": "", "version": "red"},
+		},
+		{
+			input:              []string{"pikachu", "--details"},
+			expectedPositional: []string{"pikachu"},
+			expectedFlags:      map[string]string{"details": ""},
+		},
+	}
+
+	for _, c := range cases {
+		actual := repl.ParseArgs(c.input, c.boolFlags...)
+		if !slices.Equal(actual.Positional, c.expectedPositional) {
+			t.Errorf("positional arguments of %v do not match: %v != %v", c.input, actual.Positional, c.expectedPositional)
+		}
+		if len(actual.Flags) != len(c.expectedFlags) {
+			t.Errorf("flags of %v do not match: %v != %v", c.input, actual.Flags, c.expectedFlags)
+		}
+		for name, expectedValue := range c.expectedFlags {
+			value, ok := actual.Flag(name)
+			if !ok || value != expectedValue {
+				t.Errorf("flag %v of %v does not match: %q != %q", name, c.input, value, expectedValue)
+			}
+		}
+	}
+}
+
+func TestArgsHas(t *testing.T) {
+	args := repl.ParseArgs([]string{"--details"})
+	if !args.Has("details") {
+		t.Error("Has should report a given flag")
+	}
+	if args.Has("version") {
+		t.Error("Has should not report a missing flag")
+	}
+}
@@ -0,0 +1,167 @@
+package sprite
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+)
+
+// ColorMode is how much color the terminal can show.
+type ColorMode int
+
+const (
+	// Text draws the sprite with ASCII characters only.
+	Text ColorMode = iota
+	// Color256 uses the xterm 256 color palette.
+	Color256
+	// TrueColor uses 24-bit color.
+	TrueColor
+)
+
+// DetectColorMode picks the richest mode the terminal described by the
+// environment supports. NO_COLOR and dumb terminals get Text.
+func DetectColorMode(getenv func(string) string) ColorMode {
+	if getenv("NO_COLOR") != "" {
+		return Text
+	}
+	if term := getenv("TERM"); term == "" || term == "dumb" {
+		return Text
+	}
+	if colorTerm := getenv("COLORTERM"); colorTerm == "truecolor" || colorTerm == "24bit" {
+		return TrueColor
+	}
+	return Color256
+}
+
+// textRamp goes from the lightest to the darkest shade, like ink on paper.
+const textRamp = ".:-=+*#%@"
+
+// Render draws img with one character for every two rows of pixels, using
+// half block characters colored with the top and bottom pixel. Transparent
+// borders are cropped and transparent pixels are left blank.
+func Render(w io.Writer, img image.Image, mode ColorMode) error {
+	bw := bufio.NewWriter(w)
+	bounds := opaqueBounds(img)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 2 {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			top, topOK := pixel(img, x, y)
+			bottom, bottomOK := color.NRGBA{}, false
+			if y+1 < bounds.Max.Y {
+				bottom, bottomOK = pixel(img, x, y+1)
+			}
+			if mode == Text {
+				bw.WriteByte(textCell(top, topOK, bottom, bottomOK))
+				continue
+			}
+			switch {
+			case topOK && bottomOK:
+				fmt.Fprintf(bw, "%s%s▀\x1b[0m", escape(38, top, mode), escape(48, bottom, mode))
+			case topOK:
+				fmt.Fprintf(bw, "%s▀\x1b[0m", escape(38, top, mode))
+			case bottomOK:
+				fmt.Fprintf(bw, "%s▄\x1b[0m", escape(38, bottom, mode))
+			default:
+				bw.WriteByte(' ')
+			}
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// pixel returns the color at x, y and whether it is opaque enough to draw.
+func pixel(img image.Image, x, y int) (color.NRGBA, bool) {
+	c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+	return c, c.A >= 0x80
+}
+
+// opaqueBounds returns the smallest rectangle holding every opaque pixel.
+func opaqueBounds(img image.Image) image.Rectangle {
+	var bounds image.Rectangle
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, ok := pixel(img, x, y); ok {
+				bounds = bounds.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return bounds
+}
+
+// escape returns the SGR sequence setting the foreground (38) or background
+// (48) color.
+func escape(layer int, c color.NRGBA, mode ColorMode) string {
+	if mode == TrueColor {
+		return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", layer, c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("\x1b[%d;5;%dm", layer, ansi256(c))
+}
+
+// cubeLevels are the channel values of the 6x6x6 color cube of the xterm
+// palette.
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// ansi256 returns the xterm palette index closest to c, from either the
+// color cube or the grayscale ramp.
+func ansi256(c color.NRGBA) int {
+	r, g, b := int(c.R), int(c.G), int(c.B)
+	ri, gi, bi := nearestLevel(r), nearestLevel(g), nearestLevel(b)
+	cube := 16 + 36*ri + 6*gi + bi
+	cubeDistance := distance(r, g, b, cubeLevels[ri], cubeLevels[gi], cubeLevels[bi])
+
+	// The grayscale ramp runs from 8 to 238 in steps of 10.
+	grayIndex := min(max((r+g+b)/3-8+5, 0)/10, 23)
+	grayLevel := 8 + 10*grayIndex
+	if distance(r, g, b, grayLevel, grayLevel, grayLevel) < cubeDistance {
+		return 232 + grayIndex
+	}
+	return cube
+}
+
+func nearestLevel(v int) int {
+	best := 0
+	for i, level := range cubeLevels {
+		if abs(v-level) < abs(v-cubeLevels[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+func distance(r1, g1, b1, r2, g2, b2 int) int {
+	return (r1-r2)*(r1-r2) + (g1-g2)*(g1-g2) + (b1-b2)*(b1-b2)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// textCell shades a character by the average luminance of the opaque pixels
+// it covers.
+func textCell(top color.NRGBA, topOK bool, bottom color.NRGBA, bottomOK bool) byte {
+	total, count := 0, 0
+	for _, p := range []struct {
+		c  color.NRGBA
+		ok bool
+	}{{top, topOK}, {bottom, bottomOK}} {
+		if p.ok {
+			total += luminance(p.c)
+			count++
+		}
+	}
+	if count == 0 {
+		return ' '
+	}
+	darkness := 255 - total/count
+	return textRamp[darkness*(len(textRamp)-1)/255]
+}
+
+func luminance(c color.NRGBA) int {
+	return (299*int(c.R) + 587*int(c.G) + 114*int(c.B)) / 1000
+}
@@ -0,0 +1,107 @@
+package sprite_test
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/sprite"
+)
+
+var (
+	red   = color.NRGBA{R: 255, A: 255}
+	blue  = color.NRGBA{B: 255, A: 255}
+	black = color.NRGBA{A: 255}
+)
+
+// column returns a 1 pixel wide image with the given pixels from top to
+// bottom.
+func column(pixels ...color.NRGBA) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, len(pixels)))
+	for y, c := range pixels {
+		img.Set(0, y, c)
+	}
+	return img
+}
+
+func render(t *testing.T, img image.Image, mode sprite.ColorMode) string {
+	t.Helper()
+	var out strings.Builder
+	if err := sprite.Render(&out, img, mode); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return out.String()
+}
+
+func TestRenderTrueColor(t *testing.T) {
+	out := render(t, column(red, blue), sprite.TrueColor)
+	expected := "\x1b[38;2;255;0;0m\x1b[48;2;0;0;255m▀\x1b[0m\n"
+	if out != expected {
+		t.Errorf("Expected %q, got %q", expected, out)
+	}
+}
+
+func TestRender256Color(t *testing.T) {
+	out := render(t, column(red, blue), sprite.Color256)
+	expected := "\x1b[38;5;196m\x1b[48;5;21m▀\x1b[0m\n"
+	if out != expected {
+		t.Errorf("Expected %q, got %q", expected, out)
+	}
+
+	gray := color.NRGBA{R: 128, G: 128, B: 128, A: 255}
+	if out := render(t, column(gray), sprite.Color256); !strings.Contains(out, "38;5;244m") {
+		t.Errorf("Expected gray to use the grayscale ramp, got %q", out)
+	}
+}
+
+func TestRenderTransparency(t *testing.T) {
+	// The transparent rows above and below are cropped, leaving a red pixel
+	// over a transparent one.
+	out := render(t, column(color.NRGBA{}, color.NRGBA{}, red, color.NRGBA{}, blue, color.NRGBA{}), sprite.TrueColor)
+	expected := "\x1b[38;2;255;0;0m▀\x1b[0m\n\x1b[38;2;0;0;255m▀\x1b[0m\n"
+	if out != expected {
+		t.Errorf("Expected %q, got %q", expected, out)
+	}
+
+	out = render(t, column(color.NRGBA{}, color.NRGBA{}), sprite.TrueColor)
+	if out != "" {
+		t.Errorf("Expected nothing for a fully transparent image, got %q", out)
+	}
+}
+
+func TestRenderText(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	img.Set(0, 0, black)
+	img.Set(0, 1, black)
+	img.Set(2, 0, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	out := render(t, img, sprite.Text)
+	if out != "@ .\n" {
+		t.Errorf("Expected %q, got %q", "@ .\n", out)
+	}
+}
+
+func TestDetectColorMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected sprite.ColorMode
+	}{
+		{name: "truecolor", env: map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, expected: sprite.TrueColor},
+		{name: "24bit", env: map[string]string{"TERM": "xterm", "COLORTERM": "24bit"}, expected: sprite.TrueColor},
+		{name: "256 color", env: map[string]string{"TERM": "xterm-256color"}, expected: sprite.Color256},
+		{name: "dumb", env: map[string]string{"TERM": "dumb", "COLORTERM": "truecolor"}, expected: sprite.Text},
+		{name: "no term", env: map[string]string{}, expected: sprite.Text},
+		{name: "no color", env: map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1"}, expected: sprite.Text},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+			if mode := sprite.DetectColorMode(getenv); mode != tt.expected {
+				t.Errorf("Expected mode %d, got %d", tt.expected, mode)
+			}
+		})
+	}
+}
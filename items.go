@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+	"github.com/jabreu610/pokedexcli/internal/repl"
+)
+
+func commandItem(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, an item name. Received none")
+	}
+	item, err := c.client.GetItem(ctx, c.args[0])
+	if errors.Is(err, pokeclient.ErrItemNotFound) {
+		fmt.Printf("Item %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Name: %s\n", item.Name)
+	fmt.Printf("Category: %s\n", item.Category.Name)
+	fmt.Printf("Cost: %d\n", item.Cost)
+	if item.FlingPower != nil {
+		fmt.Printf("Fling power: %d\n", *item.FlingPower)
+	}
+	if len(item.Attributes) > 0 {
+		attributes := make([]string, len(item.Attributes))
+		for i, attribute := range item.Attributes {
+			attributes[i] = attribute.Name
+		}
+		fmt.Printf("Attributes: %s\n", strings.Join(attributes, ", "))
+	}
+	if description := localizedText(c, item.Description); description != "" {
+		fmt.Println(description)
+	}
+	if len(item.HeldByPokemon) > 0 {
+		fmt.Println("Held by:")
+		for _, holder := range item.HeldByPokemon {
+			fmt.Printf("  - %s\n", holder.Pokemon.Name)
+		}
+	}
+	return nil
+}
+
+// commandItems pages through item categories like map, or lists the items of
+// one category with --category.
+func commandItems(ctx context.Context, c *Config) error {
+	args := repl.ParseArgs(c.args)
+	if category, ok := args.Flag("category"); ok {
+		return printItemCategory(ctx, c, category)
+	}
+	url := ""
+	if c.itemCategoriesNext != nil {
+		url = *c.itemCategoriesNext
+	}
+	res, err := c.client.GetItemCategories(ctx, url)
+	if err != nil {
+		return err
+	}
+	processItemCategoriesResponse(res, c)
+	return nil
+}
+
+func commandItemsb(ctx context.Context, c *Config) error {
+	if c.itemCategoriesPrev == nil {
+		fmt.Println("you're on the first page")
+		return nil
+	}
+	res, err := c.client.GetItemCategories(ctx, *c.itemCategoriesPrev)
+	if err != nil {
+		return err
+	}
+	processItemCategoriesResponse(res, c)
+	return nil
+}
+
+func processItemCategoriesResponse(d pokeclient.Page[pokeclient.Entry], c *Config) {
+	c.itemCategoriesPrev = d.Previous
+	c.itemCategoriesNext = d.Next
+	for _, category := range d.Results {
+		fmt.Println(category.Name)
+	}
+}
+
+func printItemCategory(ctx context.Context, c *Config, name string) error {
+	if name == "" {
+		return errors.New("Expected an item category after --category")
+	}
+	category, err := c.client.GetItemCategory(ctx, name)
+	if errors.Is(err, pokeclient.ErrItemCategoryNotFound) {
+		fmt.Printf("Item category %s does not exist\n", name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, item := range category.Items {
+		fmt.Println(item.Name)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCommandItem(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name:           "known item",
+			args:           []string{"leftovers"},
+			serverResponse: `{"name": "leftovers", "cost": 4000, "fling_power": 10, "category": {"name": "held-items"}, "attributes": [{"name": "holdable"}]}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			// Should not return error for unknown items, just print message
+			name:         "unknown item - 404",
+			args:         []string{"fake-item"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"leftovers"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"leftovers"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:        "no arguments",
+			args:        []string{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandItem(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCommandItemsPaging(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprintf(w, `{"count": 2, "next": "%s/item-category?offset=1", "previous": null, "results": [{"name": "stat-boosts"}]}`, server.URL)
+			return
+		}
+		fmt.Fprintf(w, `{"count": 2, "next": null, "previous": "%s/item-category", "results": [{"name": "held-items"}]}`, server.URL)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{},
+		client: newTestClient(t, server.URL),
+	}
+
+	if err := commandItemsb(context.Background(), config); err != nil {
+		t.Errorf("commandItemsb should not return error on first page, got %v", err)
+	}
+	if err := commandItems(context.Background(), config); err != nil {
+		t.Fatalf("commandItems should not return error, got %v", err)
+	}
+	if config.itemCategoriesNext == nil || config.itemCategoriesPrev != nil {
+		t.Fatal("Expected only a next page after the first page")
+	}
+	if err := commandItems(context.Background(), config); err != nil {
+		t.Fatalf("commandItems should not return error, got %v", err)
+	}
+	if config.itemCategoriesNext != nil || config.itemCategoriesPrev == nil {
+		t.Error("Expected only a previous page after the last page")
+	}
+	if config.Next != nil || config.Prev != nil {
+		t.Error("Paging item categories should not move the location area pages")
+	}
+}
+
+func TestCommandItemsCategory(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name:           "known category",
+			args:           []string{"--category", "held-items"},
+			serverResponse: `{"name": "held-items", "items": [{"name": "leftovers"}]}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			// Should not return error for unknown categories, just print message
+			name:         "unknown category - 404",
+			args:         []string{"--category", "fake-category"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"--category", "held-items"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"--category", "held-items"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:        "category flag without a value",
+			args:        []string{"--category"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandItems(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func commandLang(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		fmt.Printf("Language: %s\n", language(c))
+		return nil
+	}
+	lang, err := c.client.GetLanguage(ctx, c.args[0])
+	if errors.Is(err, pokeclient.ErrLanguageNotFound) {
+		fmt.Printf("Language %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	c.lang = lang.Name
+	fmt.Printf("Showing names and text in %s\n", lang.LocalizedName(lang.Name))
+	return nil
+}
+
+// language returns the session language chosen with the lang command.
+func language(c *Config) string {
+	if c.lang == "" {
+		return pokeclient.DefaultLanguage
+	}
+	return c.lang
+}
+
+// localizedText returns text in the session language, falling back to the
+// default language for text that has not been translated.
+func localizedText(c *Config, text func(language string) string) string {
+	if s := text(language(c)); s != "" {
+		return s
+	}
+	return text(pokeclient.DefaultLanguage)
+}
+
+// displayName returns the name to show for a resource called name. The
+// default language shows resource names, which commands take as arguments,
+// and other languages show the name the resource has in that language.
+func displayName(c *Config, resource localizedNamer, name string) string {
+	if language(c) == pokeclient.DefaultLanguage {
+		return name
+	}
+	return resource.LocalizedName(language(c))
+}
+
+// caughtName returns the name of a caught pokemon in the session language.
+func caughtName(c *Config, name string) string {
+	species, ok := c.species[name]
+	if !ok {
+		return name
+	}
+	return displayName(c, species, name)
+}
+
+// localizedNamer is a resource with names in several languages.
+type localizedNamer interface {
+	LocalizedName(language string) string
+}
+
+// localizeNames returns names in the session language like displayName,
+// fetching each resource with get. Names of resources that cannot be fetched, such as alternate
+// forms of a pokemon, are left as they are.
+func localizeNames[T localizedNamer](ctx context.Context, c *Config, names []string, get func(context.Context, string) (T, error)) ([]string, error) {
+	if language(c) == pokeclient.DefaultLanguage {
+		return names, nil
+	}
+	results := pokeclient.Batch(ctx, c.client, names, get)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	out := make([]string, len(names))
+	for i, result := range results {
+		out[i] = names[i]
+		if result.Err == nil {
+			out[i] = result.Value.LocalizedName(language(c))
+		}
+	}
+	return out, nil
+}
+
+// localizePokemonNames returns the names of pokemon in the session language.
+func localizePokemonNames(ctx context.Context, c *Config, names []string) ([]string, error) {
+	return localizeNames(ctx, c, names, c.client.GetPokemonSpecies)
+}
+
+// localizeEncounters returns a copy of encounters with the pokemon names in
+// the session language.
+func localizeEncounters(ctx context.Context, c *Config, encounters []pokeclient.EncounterEntry) ([]pokeclient.EncounterEntry, error) {
+	names := make([]string, len(encounters))
+	for i, encounter := range encounters {
+		names[i] = encounter.Pokemon.Name
+	}
+	localized, err := localizePokemonNames(ctx, c, names)
+	if err != nil {
+		return nil, err
+	}
+	out := slices.Clone(encounters)
+	for i := range out {
+		out[i].Pokemon.Name = localized[i]
+	}
+	return out, nil
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func newLangServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pokemon-species":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count": 1, "next": null, "results": [{"name": "pikachu"}]}`))
+		case "/pokemon-species/pikachu":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "pikachu", "names": [{"name": "ピカチュウ", "language": {"name": "ja"}}, {"name": "Pikachu", "language": {"name": "en"}}], "varieties": [{"is_default": true, "pokemon": {"name": "pikachu"}}]}`))
+		case "/ability/static":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "static", "names": [{"name": "せいでんき", "language": {"name": "ja"}}, {"name": "Static", "language": {"name": "en"}}], "pokemon": [{"is_hidden": false, "pokemon": {"name": "pikachu"}}]}`))
+		case "/pokemon/pikachu":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "pikachu", "species": {"name": "pikachu"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCommandLang(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+		expectedLang   string
+	}{
+		{
+			name:           "known language",
+			args:           []string{"ja"},
+			serverResponse: `{"name": "ja", "names": [{"name": "日本語", "language": {"name": "ja"}}]}`,
+			serverStatus:   http.StatusOK,
+			expectedLang:   "ja",
+		},
+		{
+			// Should not return error or change the language for unknown languages
+			name:         "unknown language - 404",
+			args:         []string{"xx"},
+			serverStatus: http.StatusNotFound,
+			expectedLang: "en",
+		},
+		{
+			name:         "server error",
+			args:         []string{"ja"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+			expectedLang: "en",
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"ja"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+			expectedLang:   "en",
+		},
+		{
+			name:         "no arguments shows the language",
+			args:         []string{},
+			expectedLang: "en",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandLang(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if language(config) != tt.expectedLang {
+				t.Errorf("Expected language %s, got %s", tt.expectedLang, language(config))
+			}
+		})
+	}
+}
+
+func TestLookupPokemonLocalized(t *testing.T) {
+	server := newLangServer()
+	defer server.Close()
+
+	config := &Config{
+		client: newTestClient(t, server.URL),
+		lang:   "ja",
+	}
+	if _, err := lookupPokemon(context.Background(), config, "ピカチュウ"); !errors.Is(err, pokeclient.ErrPokemonNotFound) {
+		t.Errorf("Expected lookupPokemon not to match localized names, got %v", err)
+	}
+	if requests := config.client.Stats().Requests; requests != 1 {
+		t.Errorf("Expected lookupPokemon not to index species names, got %d requests", requests)
+	}
+
+	pokemon, err := lookupLocalizedPokemon(context.Background(), config, "ピカチュウ")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pokemon.Name != "pikachu" {
+		t.Errorf("Expected pikachu, got %s", pokemon.Name)
+	}
+
+	if _, err := lookupLocalizedPokemon(context.Background(), config, "ライチュウ"); err == nil {
+		t.Error("Expected an error for an unknown localized name")
+	}
+}
+
+func TestFindCaughtLocalized(t *testing.T) {
+	config := &Config{
+		lang:    "ja",
+		pokedex: map[string]pokeclient.Pokemon{"pikachu": {Name: "pikachu"}},
+		species: map[string]pokeclient.PokemonSpecies{
+			"pikachu": {Name: "pikachu", Names: []pokeclient.Name{
+				{Name: "ピカチュウ", Language: pokeclient.Entry{Name: "ja"}},
+				{Name: "Pikachu", Language: pokeclient.Entry{Name: "en"}},
+			}},
+		},
+	}
+
+	for _, name := range []string{"pikachu", "ピカチュウ"} {
+		if pokemon, ok := findCaught(config, name); !ok || pokemon.Name != "pikachu" {
+			t.Errorf("Expected %s to find pikachu, got %+v", name, pokemon)
+		}
+	}
+	if _, ok := findCaught(config, "ライチュウ"); ok {
+		t.Error("Expected a pokemon that was not caught not to be found")
+	}
+	if name := caughtName(config, "pikachu"); name != "ピカチュウ" {
+		t.Errorf("Expected the Japanese name, got %s", name)
+	}
+
+	config.lang = ""
+	if name := caughtName(config, "pikachu"); name != "pikachu" {
+		t.Errorf("Expected the resource name in English, got %s", name)
+	}
+}
+
+func TestLocalizeEncounters(t *testing.T) {
+	server := newLangServer()
+	defer server.Close()
+
+	config := &Config{
+		client: newTestClient(t, server.URL),
+		lang:   "ja",
+	}
+	encounters := []pokeclient.EncounterEntry{
+		{Pokemon: pokeclient.PokemonEntry{Name: "pikachu"}},
+		{Pokemon: pokeclient.PokemonEntry{Name: "pikachu-rock-star"}},
+	}
+
+	localized, err := localizeEncounters(context.Background(), config, encounters)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if localized[0].Pokemon.Name != "ピカチュウ" || localized[1].Pokemon.Name != "pikachu-rock-star" {
+		t.Errorf("Unexpected names %+v", localized)
+	}
+	if encounters[0].Pokemon.Name != "pikachu" {
+		t.Error("Expected the original encounters to be left unchanged")
+	}
+}
+
+func TestLocalizeAbilityNames(t *testing.T) {
+	server := newLangServer()
+	defer server.Close()
+
+	config := &Config{
+		client: newTestClient(t, server.URL),
+		lang:   "ja",
+	}
+	names, err := localizeNames(context.Background(), config, []string{"static", "lightning-rod"}, config.client.GetAbility)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(names, []string{"せいでんき", "lightning-rod"}) {
+		t.Errorf("Unexpected names %v", names)
+	}
+
+	config.lang = ""
+	if names, _ := localizeNames(context.Background(), config, []string{"static"}, config.client.GetAbility); names[0] != "static" {
+		t.Errorf("Expected the resource name in English, got %s", names[0])
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	species := pokeclient.PokemonSpecies{Name: "pikachu", Names: []pokeclient.Name{
+		{Name: "ピカチュウ", Language: pokeclient.Entry{Name: "ja"}},
+		{Name: "Pikachu", Language: pokeclient.Entry{Name: "en"}},
+	}}
+
+	config := &Config{}
+	if name := displayName(config, species, "pikachu"); name != "pikachu" {
+		t.Errorf("Expected the resource name in the default language, got %s", name)
+	}
+	config.lang = "ja"
+	if name := displayName(config, species, "pikachu"); name != "ピカチュウ" {
+		t.Errorf("Expected the Japanese name, got %s", name)
+	}
+	config.lang = "fr"
+	if name := displayName(config, species, "pikachu"); name != "pikachu" {
+		t.Errorf("Expected the resource name for a missing language, got %s", name)
+	}
+}
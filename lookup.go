@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+// lookupPokemon fetches the pokemon identified by key, which can be its name,
+// a National Dex number such as "#25" or a resource ID such as "25". Unknown
+// pokemon are reported as pokeclient.ErrPokemonNotFound.
+func lookupPokemon(ctx context.Context, c *Config, key string) (pokeclient.Pokemon, error) {
+	name, err := c.client.ResolvePokemonName(ctx, key)
+	if err != nil {
+		return pokeclient.Pokemon{}, err
+	}
+	return c.client.GetPokemon(ctx, name)
+}
+
+// lookupLocalizedPokemon is like lookupPokemon, but key can also be the name
+// of the pokemon's species in the session language. The first unknown name
+// indexes the names of every species, so only catch looks pokemon up this
+// way.
+func lookupLocalizedPokemon(ctx context.Context, c *Config, key string) (pokeclient.Pokemon, error) {
+	pokemon, err := lookupPokemon(ctx, c, key)
+	if !errors.Is(err, pokeclient.ErrPokemonNotFound) || language(c) == pokeclient.DefaultLanguage {
+		return pokemon, err
+	}
+	if c.client.SpeciesIndexPending() {
+		fmt.Println("Indexing the names of every species, this can take a while...")
+	}
+	species, findErr := c.client.FindSpecies(ctx, key, language(c))
+	if errors.Is(findErr, pokeclient.ErrSpeciesNotFound) {
+		return pokemon, err
+	}
+	if findErr != nil {
+		return pokemon, findErr
+	}
+	return c.client.GetPokemon(ctx, species.DefaultPokemon())
+}
+
+// findCaught returns the caught pokemon identified by key like
+// lookupLocalizedPokemon, without making any requests.
+func findCaught(c *Config, key string) (pokeclient.Pokemon, bool) {
+	if pokemon, ok := c.pokedex[key]; ok {
+		return pokemon, true
+	}
+	dexNumber, isDexNumber := pokeclient.ParseDexNumber(key)
+	id, err := strconv.Atoi(key)
+	for name, pokemon := range c.pokedex {
+		switch {
+		case isDexNumber && pokemon.DexNumber() == dexNumber:
+			return pokemon, true
+		case err == nil && pokemon.ID == id:
+			return pokemon, true
+		case !isDexNumber && err != nil && strings.EqualFold(caughtName(c, name), key):
+			return pokemon, true
+		}
+	}
+	return pokeclient.Pokemon{}, false
+}
+
+// dexNumber returns the National Dex number of a caught pokemon, or 0 when it
+// is unknown.
+func dexNumber(c *Config, pokemon pokeclient.Pokemon) int {
+	if n := pokemon.DexNumber(); n != 0 {
+		return n
+	}
+	return c.species[pokemon.Name].ID
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func caughtPokemon(name string, id, dexNumber int) pokeclient.Pokemon {
+	return pokeclient.Pokemon{
+		ID:      id,
+		Name:    name,
+		Species: pokeclient.Entry{Url: fmt.Sprintf("https://pokeapi.co/api/v2/pokemon-species/%d/", dexNumber)},
+	}
+}
+
+func TestLookupPokemonByDexNumber(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pokemon-species/25":
+			w.Write([]byte(`{"id": 25, "name": "pikachu", "varieties": [{"is_default": true, "pokemon": {"name": "pikachu"}}]}`))
+		case "/pokemon/25", "/pokemon/pikachu":
+			w.Write([]byte(`{"id": 25, "name": "pikachu"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{client: newTestClient(t, server.URL)}
+	for _, key := range []string{"#25", "25", "pikachu"} {
+		pokemon, err := lookupPokemon(context.Background(), config, key)
+		if err != nil {
+			t.Errorf("Looking up %s: unexpected error %v", key, err)
+			continue
+		}
+		if pokemon.Name != "pikachu" {
+			t.Errorf("Expected %s to resolve to pikachu, got %s", key, pokemon.Name)
+		}
+	}
+}
+
+func TestFindCaughtByNumber(t *testing.T) {
+	config := &Config{
+		pokedex: map[string]pokeclient.Pokemon{
+			"pikachu":        caughtPokemon("pikachu", 25, 25),
+			"deoxys-defense": caughtPokemon("deoxys-defense", 10002, 386),
+		},
+	}
+
+	cases := map[string]string{
+		"#25":   "pikachu",
+		"25":    "pikachu",
+		"#386":  "deoxys-defense",
+		"10002": "deoxys-defense",
+	}
+	for key, expected := range cases {
+		if pokemon, ok := findCaught(config, key); !ok || pokemon.Name != expected {
+			t.Errorf("Expected %s to find %s, got %+v", key, expected, pokemon)
+		}
+	}
+	if _, ok := findCaught(config, "386"); ok {
+		t.Error("Expected a bare number to match the pokemon ID, not the dex number")
+	}
+}
+
+func TestDexNumberFallsBackToSpecies(t *testing.T) {
+	config := &Config{
+		species: map[string]pokeclient.PokemonSpecies{"pikachu": {ID: 25, Name: "pikachu"}},
+	}
+	if n := dexNumber(config, pokeclient.Pokemon{Name: "pikachu"}); n != 25 {
+		t.Errorf("Expected dex number 25 from the species, got %d", n)
+	}
+	if n := dexNumber(config, pokeclient.Pokemon{Name: "mew"}); n != 0 {
+		t.Errorf("Expected an unknown dex number to be 0, got %d", n)
+	}
+}
+
+func TestSortedPokedex(t *testing.T) {
+	config := &Config{
+		pokedex: map[string]pokeclient.Pokemon{
+			"mewtwo":         caughtPokemon("mewtwo", 150, 150),
+			"deoxys-speed":   caughtPokemon("deoxys-speed", 10003, 386),
+			"pikachu":        caughtPokemon("pikachu", 25, 25),
+			"deoxys-defense": caughtPokemon("deoxys-defense", 10002, 386),
+			"bulbasaur":      caughtPokemon("bulbasaur", 1, 1),
+		},
+	}
+
+	var names []string
+	for _, pokemon := range sortedPokedex(config) {
+		names = append(names, pokemon.Name)
+	}
+	expected := []string{"bulbasaur", "pikachu", "mewtwo", "deoxys-defense", "deoxys-speed"}
+	if !slices.Equal(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
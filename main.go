@@ -2,16 +2,25 @@ package main
 
 import (
 	"bufio"
+	"cmp"
 	"context"
 	"errors"
+	"flag"
 	"fmt"
+	"log/slog"
+	"maps"
 	"math/rand/v2"
 	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/jabreu610/pokedexcli/internal/pokecache"
 	"github.com/jabreu610/pokedexcli/internal/pokeclient"
 	"github.com/jabreu610/pokedexcli/internal/repl"
+	"github.com/jabreu610/pokedexcli/internal/sprite"
 )
 
 const defaultInterval = time.Second * 5
@@ -19,19 +28,60 @@ const defaultInterval = time.Second * 5
 type Config struct {
 	Next    *string
 	Prev    *string
-	cache   *pokecache.Cache
+	client  *pokeclient.Client
 	args    []string
 	pokedex map[string]pokeclient.Pokemon
+	species map[string]pokeclient.PokemonSpecies
+
+	itemCategoriesNext *string
+	itemCategoriesPrev *string
+	regionAreas        *regionAreas
+	game               *gameSetting
+	colorMode          sprite.ColorMode
+	lang               string
 }
 
 type cliCommand struct {
 	Name        string
 	Description string
-	Callback    func(*Config) error
+	Callback    func(context.Context, *Config) error
 }
 
 var commands map[string]cliCommand
 
+// commandCanceler tracks the context of the command currently running so a
+// SIGINT can cancel it without leaving the REPL.
+type commandCanceler struct {
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func (cc *commandCanceler) start(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	cc.mu.Lock()
+	cc.cancel = cancel
+	cc.mu.Unlock()
+	return ctx, func() {
+		cc.mu.Lock()
+		cc.cancel = nil
+		cc.mu.Unlock()
+		cancel()
+	}
+}
+
+// interrupt cancels the running command. It reports false when the REPL is
+// idle at the prompt.
+func (cc *commandCanceler) interrupt() bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.cancel == nil {
+		return false
+	}
+	cc.cancel()
+	cc.cancel = nil
+	return true
+}
+
 func passWithDifficulty(baseExp int) bool {
 	// Normalize 36-635 to 0.0-1.0
 	normalizedDifficulty := float64(baseExp-36) / float64(635-36)
@@ -50,13 +100,13 @@ func processLocationAreaResponse(d pokeclient.LocationAreaResponse, c *Config) {
 	}
 }
 
-func commandExit(c *Config) error {
+func commandExit(ctx context.Context, c *Config) error {
 	fmt.Println("Closing the Pokedex... Goodbye!")
 	os.Exit(0)
 	return nil
 }
 
-func commandHelp(c *Config) error {
+func commandHelp(ctx context.Context, c *Config) error {
 	fmt.Print("Welcome to the Pokedex!\nUsage:\n\n")
 	for _, command := range commands {
 		helpMessage := fmt.Sprintf("%s: %s", command.Name, command.Description)
@@ -65,12 +115,15 @@ func commandHelp(c *Config) error {
 	return nil
 }
 
-func commandMap(c *Config) error {
-	url := pokeclient.BaseUrlLocationArea
+func commandMap(ctx context.Context, c *Config) error {
+	if region, ok := repl.ParseArgs(c.args).Flag("region"); ok {
+		return commandMapRegion(ctx, c, region)
+	}
+	url := ""
 	if c.Next != nil {
 		url = *c.Next
 	}
-	res, err := pokeclient.GetLocationAreas(url, c.cache)
+	res, err := c.client.GetLocationAreas(ctx, url)
 	if err != nil {
 		return err
 	}
@@ -78,12 +131,15 @@ func commandMap(c *Config) error {
 	return nil
 }
 
-func commandMapb(c *Config) error {
+func commandMapb(ctx context.Context, c *Config) error {
+	if region, ok := repl.ParseArgs(c.args).Flag("region"); ok {
+		return commandMapbRegion(ctx, c, region)
+	}
 	if c.Prev == nil {
 		fmt.Println("you're on the first page")
 		return nil
 	}
-	res, err := pokeclient.GetLocationAreas(*c.Prev, c.cache)
+	res, err := c.client.GetLocationAreas(ctx, *c.Prev)
 	if err != nil {
 		return err
 	}
@@ -91,58 +147,92 @@ func commandMapb(c *Config) error {
 	return nil
 }
 
-func commandExplore(c *Config) error {
-	if len(c.args) < 1 {
+func commandExplore(ctx context.Context, c *Config) error {
+	args := repl.ParseArgs(c.args, "details")
+	if len(args.Positional) < 1 {
 		return errors.New("Expected one arguement, a location area name. Recieved none")
 	}
-	pokemon, err := pokeclient.GetPokemonForLocationName(c.args[0], c.cache)
+	area := args.Positional[0]
+	encounters, err := c.client.GetLocationAreaEncounters(ctx, area)
+	if errors.Is(err, pokeclient.ErrLocationAreaNotFound) {
+		fmt.Printf("Location area %s does not exist\n", area)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	encounters = filterEncounterVersions(encounters, versionFlag(args, c))
+	encounters, err = localizeEncounters(ctx, c, encounters)
 	if err != nil {
 		return err
 	}
-	for _, name := range pokemon {
-		fmt.Println(name)
+	if args.Has("details") {
+		printEncounters(os.Stdout, encounters)
+		return nil
+	}
+	for _, encounter := range encounters {
+		fmt.Println(encounter.Pokemon.Name)
 	}
 	return nil
 }
 
-func commandCatch(c *Config) error {
+func commandCatch(ctx context.Context, c *Config) error {
 	if len(c.args) < 1 {
 		return errors.New("Expected one arguement, a Pokemon name. Recieved none")
 	}
-	pokemon, err := pokeclient.GetPokemon(c.args[0], c.cache)
+	// Localized names can contain spaces, such as "M. Mime" in French.
+	name := strings.Join(c.args, " ")
+	pokemon, err := lookupLocalizedPokemon(ctx, c, name)
 	if errors.Is(err, pokeclient.ErrPokemonNotFound) {
-		msg := fmt.Sprintf("Pokemon %s does not exist", c.args[0])
+		msg := fmt.Sprintf("Pokemon %s does not exist", name)
 		fmt.Println(msg)
 		return nil
 	}
 	if err != nil {
 		return err
 	}
-	catchIntro := fmt.Sprintf("Throwing a Pokeball at %s...", pokemon.Name)
+	species, err := c.client.GetPokemonSpecies(ctx, pokemon.Species.Name)
+	if err != nil {
+		return err
+	}
+	shownName := displayName(c, species, pokemon.Name)
+	catchIntro := fmt.Sprintf("Throwing a Pokeball at %s...", shownName)
 	fmt.Println(catchIntro)
 	caught := passWithDifficulty(pokemon.BaseExperience)
 	if caught {
-		caughtMsg := fmt.Sprintf("%s was caught!", pokemon.Name)
+		caughtMsg := fmt.Sprintf("%s was caught!", shownName)
 		fmt.Println(caughtMsg)
 		fmt.Println("You may now inspect it with the inspect command.")
 		c.pokedex[pokemon.Name] = pokemon
+		c.species[pokemon.Name] = species
 	} else {
-		failedMsg := fmt.Sprintf("%s escaped!", pokemon.Name)
+		failedMsg := fmt.Sprintf("%s escaped!", shownName)
 		fmt.Println(failedMsg)
 	}
 	return nil
 }
 
-func commandInspect(c *Config) error {
-	if len(c.args) < 1 {
+func commandInspect(ctx context.Context, c *Config) error {
+	args := repl.ParseArgs(c.args, "sprite")
+	if len(args.Positional) < 1 {
 		return errors.New("Expected one arguement, a Pokemon name. Recieved none")
 	}
-	pokemon, ok := c.pokedex[c.args[0]]
+	name := strings.Join(args.Positional, " ")
+	pokemon, ok := findCaught(c, name)
 	if !ok {
-		fmt.Printf("%s has not been caught!", c.args[0])
+		fmt.Printf("%s has not been caught!", name)
 		return nil
 	}
-	fmt.Printf("Name: %s\n", pokemon.Name)
+	if args.Has("sprite") {
+		if err := printSprite(ctx, c, pokemon); err != nil {
+			return err
+		}
+	}
+	if shownName := caughtName(c, pokemon.Name); shownName != pokemon.Name {
+		fmt.Printf("Name: %s (%s)\n", shownName, pokemon.Name)
+	} else {
+		fmt.Printf("Name: %s\n", pokemon.Name)
+	}
 	fmt.Printf("Height: %d\n", pokemon.Height)
 	fmt.Printf("Weight: %d\n", pokemon.Weight)
 	fmt.Println("Stats:")
@@ -153,21 +243,108 @@ func commandInspect(c *Config) error {
 	for _, typeEntry := range pokemon.Types {
 		fmt.Printf("  - %s\n", typeEntry.Type.Name)
 	}
+	abilities := make([]string, len(pokemon.Abilities))
+	for i, ability := range pokemon.Abilities {
+		abilities[i] = ability.Ability.Name
+	}
+	abilities, err := localizeNames(ctx, c, abilities, c.client.GetAbility)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Abilities:")
+	for i, ability := range pokemon.Abilities {
+		fmt.Printf("  - %s%s\n", abilities[i], hiddenSuffix(ability.IsHidden))
+	}
+	if species, ok := c.species[pokemon.Name]; ok {
+		printSpecies(c, species)
+	}
 	return nil
 }
 
-func commandPokedex(c *Config) error {
+func printSprite(ctx context.Context, c *Config, pokemon pokeclient.Pokemon) error {
+	img, err := c.client.GetSprite(ctx, pokemon.Sprites.FrontDefault)
+	if errors.Is(err, pokeclient.ErrNoSprite) {
+		fmt.Printf("%s has no sprite\n", pokemon.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return sprite.Render(os.Stdout, img, c.colorMode)
+}
+
+// printSpecies prints the species details in the session language, with the
+// pokedex entry of the session game when there is one.
+func printSpecies(c *Config, species pokeclient.PokemonSpecies) {
+	if genus := localizedText(c, species.Genus); genus != "" {
+		fmt.Printf("Genus: %s\n", genus)
+	}
+	switch {
+	case species.IsLegendary:
+		fmt.Println("Legendary Pokemon")
+	case species.IsMythical:
+		fmt.Println("Mythical Pokemon")
+	}
+	fmt.Printf("Capture rate: %d\n", species.CaptureRate)
+	if species.BaseHappiness != nil {
+		fmt.Printf("Base happiness: %d\n", *species.BaseHappiness)
+	}
+	fmt.Printf("Growth rate: %s\n", species.GrowthRate.Name)
+	if species.GenderRate < 0 {
+		fmt.Println("Gender: genderless")
+	} else {
+		fmt.Printf("Gender: %.1f%% female\n", float64(species.GenderRate)/8*100)
+	}
+	fmt.Println("Egg groups:")
+	for _, group := range species.EggGroups {
+		fmt.Printf("  - %s\n", group.Name)
+	}
+	text := ""
+	if c.game != nil {
+		text = localizedText(c, func(language string) string {
+			return species.VersionFlavorText(language, c.game.version)
+		})
+	}
+	if text == "" {
+		text = localizedText(c, species.FlavorText)
+	}
+	if text != "" {
+		fmt.Println(text)
+	}
+}
+
+// sortedPokedex returns the caught pokemon in National Dex order, with forms
+// of the same species ordered by name.
+func sortedPokedex(c *Config) []pokeclient.Pokemon {
+	caught := slices.Collect(maps.Values(c.pokedex))
+	slices.SortFunc(caught, func(a, b pokeclient.Pokemon) int {
+		return cmp.Or(cmp.Compare(dexNumber(c, a), dexNumber(c, b)), cmp.Compare(a.Name, b.Name))
+	})
+	return caught
+}
+
+func commandPokedex(ctx context.Context, c *Config) error {
 	if len(c.pokedex) == 0 {
 		fmt.Println("Pokedex is empty!")
 		return nil
 	}
 	fmt.Println("Your Pokedex:")
-	for name, _ := range c.pokedex {
-		fmt.Printf("  - %s\n", name)
+	for _, pokemon := range sortedPokedex(c) {
+		fmt.Printf("  - #%04d %s\n", dexNumber(c, pokemon), caughtName(c, pokemon.Name))
 	}
 	return nil
 }
 
+func commandStats(ctx context.Context, c *Config) error {
+	stats := c.client.Stats()
+	fmt.Printf("Requests: %d\n", stats.Requests)
+	fmt.Printf("Retries: %d\n", stats.Retries)
+	fmt.Printf("Throttled: %d (waited %v)\n", stats.Throttled, stats.ThrottleWait.Round(time.Millisecond))
+	fmt.Printf("Deduplicated: %d\n", stats.Deduplicated)
+	fmt.Printf("Revalidated: %d\n", stats.Revalidated)
+	return nil
+}
+
 func init() {
 	commands = map[string]cliCommand{
 		"exit": {
@@ -182,45 +359,165 @@ func init() {
 		},
 		"map": {
 			Name:        "map",
-			Description: "Displays location areas",
+			Description: "Displays location areas, or only those of one region with --region <region>",
 			Callback:    commandMap,
 		},
 		"mapb": {
 			Name:        "mapb",
-			Description: "Displays the previous page of location areas",
+			Description: "Displays the previous page of location areas, usage: mapb [--region <region>]",
 			Callback:    commandMapb,
 		},
+		"regions": {
+			Name:        "regions",
+			Description: "List every region",
+			Callback:    commandRegions,
+		},
+		"locations": {
+			Name:        "locations",
+			Description: "List the locations of a region, expects a region name as an argument",
+			Callback:    commandLocations,
+		},
 		"explore": {
 			Name:        "explore",
-			Description: "List Pokemon for a given location area",
+			Description: "List Pokemon for a given location area, usage: explore <area> [--details] [--version <game>]",
 			Callback:    commandExplore,
 		},
+		"where": {
+			Name:        "where",
+			Description: "List the location areas a pokemon can be found in, usage: where <pokemon> [--version <game>]",
+			Callback:    commandWhere,
+		},
 		"catch": {
 			Name:        "catch",
-			Description: "Attenpt a Pokemon, expects a pokemon name as an argument",
+			Description: "Attenpt a Pokemon, expects a pokemon name, dex number (#25) or ID as an argument",
 			Callback:    commandCatch,
 		},
 		"inspect": {
 			Name:        "inspect",
-			Description: "Check the Pokedex for caught pokemon stats",
+			Description: "Check the Pokedex for caught pokemon stats and species details, usage: inspect <pokemon> [--sprite]",
 			Callback:    commandInspect,
 		},
 		"pokedex": {
 			Name:        "pokedex",
-			Description: "List Pokemon recorded in the Pokedex after they are caught",
+			Description: "List Pokemon recorded in the Pokedex after they are caught, by dex number",
 			Callback:    commandPokedex,
 		},
+		"evolutions": {
+			Name:        "evolutions",
+			Description: "Show the evolution chain of a pokemon, expects a pokemon name as an argument",
+			Callback:    commandEvolutions,
+		},
+		"moves": {
+			Name:        "moves",
+			Description: "List the moves a pokemon learns, usage: moves <pokemon> [--version <version-group>] [--method <method>] [--sort level|name|power|accuracy|pp|type]",
+			Callback:    commandMoves,
+		},
+		"ability": {
+			Name:        "ability",
+			Description: "Describe an ability and list the pokemon that can have it",
+			Callback:    commandAbility,
+		},
+		"type": {
+			Name:        "type",
+			Description: "Show what a type is strong and weak against when attacking and defending",
+			Callback:    commandType,
+		},
+		"weakness": {
+			Name:        "weakness",
+			Description: "Show how much damage each attacking type deals to a pokemon",
+			Callback:    commandWeakness,
+		},
+		"item": {
+			Name:        "item",
+			Description: "Describe an item, expects an item name as an argument",
+			Callback:    commandItem,
+		},
+		"items": {
+			Name:        "items",
+			Description: "Displays item categories, or the items in one with --category <category>",
+			Callback:    commandItems,
+		},
+		"itemsb": {
+			Name:        "itemsb",
+			Description: "Displays the previous page of item categories",
+			Callback:    commandItemsb,
+		},
+		"berry": {
+			Name:        "berry",
+			Description: "Describe a berry, expects a berry name as an argument",
+			Callback:    commandBerry,
+		},
+		"berries": {
+			Name:        "berries",
+			Description: "List the berries with a flavor, most potent first, usage: berries --flavor <flavor>",
+			Callback:    commandBerries,
+		},
+		"nature": {
+			Name:        "nature",
+			Description: "Show the stats a nature raises and lowers, expects a nature name as an argument",
+			Callback:    commandNature,
+		},
+		"growth": {
+			Name:        "growth",
+			Description: "Show the experience a pokemon needs for each level, expects a pokemon name as an argument",
+			Callback:    commandGrowth,
+		},
+		"game": {
+			Name:        "game",
+			Description: "Choose the game version that explore, where, moves and inspect show data for, usage: game <version> | game --clear",
+			Callback:    commandGame,
+		},
+		"lang": {
+			Name:        "lang",
+			Description: "Choose the language of names and text, such as lang ja or lang de",
+			Callback:    commandLang,
+		},
+		"stats": {
+			Name:        "stats",
+			Description: "Show PokeAPI request and cache counters",
+			Callback:    commandStats,
+		},
 	}
 }
 
 func main() {
+	debug := flag.Bool("debug", false, "log debug output, such as request retries, to stderr")
+	flag.Parse()
+
+	clientOpts := []pokeclient.Option{
+		pokeclient.WithCache(pokecache.NewCache(defaultInterval, context.Background())),
+	}
+	if *debug {
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		clientOpts = append(clientOpts, pokeclient.WithLogger(logger))
+	}
+
+	colorMode := sprite.DetectColorMode(os.Getenv)
+	if info, err := os.Stdout.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		colorMode = sprite.Text
+	}
+
 	scanner := bufio.NewScanner(os.Stdin)
 	config := Config{
-		cache:   pokecache.NewCache(defaultInterval, context.Background()),
-		pokedex: map[string]pokeclient.Pokemon{},
+		client:    pokeclient.NewClient(clientOpts...),
+		pokedex:   map[string]pokeclient.Pokemon{},
+		species:   map[string]pokeclient.PokemonSpecies{},
+		colorMode: colorMode,
 	}
+	canceler := commandCanceler{}
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	go func() {
+		for range interrupts {
+			if !canceler.interrupt() {
+				fmt.Println()
+				commandExit(context.Background(), &config)
+			}
+		}
+	}()
+
 	for {
-		fmt.Print("Pokedex > ")
+		fmt.Print(prompt(&config))
 		scanner.Scan()
 		if err := scanner.Err(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error reading input: %v\n", err)
@@ -240,7 +537,14 @@ func main() {
 			fmt.Println("Unknown command")
 			continue
 		}
-		if err := command.Callback(&config); err != nil {
+		ctx, done := canceler.start(context.Background())
+		err := command.Callback(ctx, &config)
+		done()
+		if errors.Is(err, context.Canceled) {
+			fmt.Println("\nCommand cancelled")
+			continue
+		}
+		if err != nil {
 			fmt.Println(err)
 		}
 	}
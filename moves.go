@@ -0,0 +1,200 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+	"github.com/jabreu610/pokedexcli/internal/repl"
+)
+
+// learnsetRow is one way a pokemon learns a move in a version group. A move
+// learned both by level-up and from a machine has two rows. name is the name
+// of the move shown and sorted by.
+type learnsetRow struct {
+	name   string
+	move   pokeclient.Move
+	method string
+	level  int
+}
+
+// compareOptional orders nil values after all others, and larger values
+// first.
+func compareOptional(a, b *int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return cmp.Compare(*b, *a)
+}
+
+var learnsetSorts = map[string]func(a, b learnsetRow) int{
+	"level": func(a, b learnsetRow) int {
+		// Level-up moves first, in the order they are learned.
+		return cmp.Or(
+			cmp.Compare(methodRank(a.method), methodRank(b.method)),
+			cmp.Compare(a.level, b.level),
+			strings.Compare(a.name, b.name),
+		)
+	},
+	"name": func(a, b learnsetRow) int {
+		return strings.Compare(a.name, b.name)
+	},
+	"power": func(a, b learnsetRow) int {
+		return cmp.Or(compareOptional(a.move.Power, b.move.Power), strings.Compare(a.name, b.name))
+	},
+	"accuracy": func(a, b learnsetRow) int {
+		return cmp.Or(compareOptional(a.move.Accuracy, b.move.Accuracy), strings.Compare(a.name, b.name))
+	},
+	"pp": func(a, b learnsetRow) int {
+		return cmp.Or(compareOptional(a.move.PP, b.move.PP), strings.Compare(a.name, b.name))
+	},
+	"type": func(a, b learnsetRow) int {
+		return cmp.Or(strings.Compare(a.move.Type.Name, b.move.Type.Name), strings.Compare(a.name, b.name))
+	},
+}
+
+func methodRank(method string) int {
+	if method == "level-up" {
+		return 0
+	}
+	return 1
+}
+
+// latestVersionGroup returns the newest version group any of the moves can
+// be learned in. Version group IDs grow with each release.
+func latestVersionGroup(moves []pokeclient.PokemonMove) string {
+	latest := pokeclient.Entry{}
+	for _, move := range moves {
+		for _, detail := range move.VersionGroupDetails {
+			if detail.VersionGroup.ID() > latest.ID() {
+				latest = detail.VersionGroup
+			}
+		}
+	}
+	return latest.Name
+}
+
+// filterLearnset returns the rows for moves learned in versionGroup, by
+// method if it is not empty. The rows only carry the move's resource name.
+func filterLearnset(moves []pokeclient.PokemonMove, versionGroup, method string) []learnsetRow {
+	var rows []learnsetRow
+	for _, move := range moves {
+		for _, detail := range move.VersionGroupDetails {
+			if detail.VersionGroup.Name != versionGroup {
+				continue
+			}
+			if method != "" && detail.MoveLearnMethod.Name != method {
+				continue
+			}
+			rows = append(rows, learnsetRow{
+				name:   move.Move.Name,
+				move:   pokeclient.Move{Name: move.Move.Name},
+				method: detail.MoveLearnMethod.Name,
+				level:  detail.LevelLearnedAt,
+			})
+		}
+	}
+	return rows
+}
+
+func formatOptional(value *int) string {
+	if value == nil {
+		return "-"
+	}
+	return strconv.Itoa(*value)
+}
+
+// printLearnset prints the learnset with move effects in the given language.
+// Effects that have not been translated are shown in the default language.
+func printLearnset(w io.Writer, rows []learnsetRow, language string) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "LEVEL\tMOVE\tMETHOD\tTYPE\tCLASS\tPOWER\tACC\tPP\tEFFECT")
+	for _, row := range rows {
+		level := "-"
+		if row.method == "level-up" {
+			level = strconv.Itoa(row.level)
+		}
+		effect := row.move.Effect(language)
+		if effect == "" {
+			effect = row.move.Effect(pokeclient.DefaultLanguage)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			level, row.name, row.method, row.move.Type.Name, row.move.DamageClass.Name,
+			formatOptional(row.move.Power), formatOptional(row.move.Accuracy), formatOptional(row.move.PP), effect)
+	}
+	tw.Flush()
+}
+
+func commandMoves(ctx context.Context, c *Config) error {
+	args := repl.ParseArgs(c.args)
+	if len(args.Positional) < 1 {
+		return errors.New("Expected one argument, a Pokemon name. Received none")
+	}
+	sortName, ok := args.Flag("sort")
+	if !ok {
+		sortName = "level"
+	}
+	sortFunc, ok := learnsetSorts[sortName]
+	if !ok {
+		return fmt.Errorf("Unknown sort %s, expected one of level, name, power, accuracy, pp or type", sortName)
+	}
+
+	pokemon, err := lookupPokemon(ctx, c, args.Positional[0])
+	if errors.Is(err, pokeclient.ErrPokemonNotFound) {
+		fmt.Printf("Pokemon %s does not exist\n", args.Positional[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	versionGroup, ok := args.Flag("version")
+	if !ok {
+		versionGroup = latestVersionGroup(pokemon.Moves)
+		if c.game != nil {
+			versionGroup = c.game.versionGroup
+		}
+	}
+	method, _ := args.Flag("method")
+	rows := filterLearnset(pokemon.Moves, versionGroup, method)
+	if len(rows) == 0 {
+		fmt.Printf("%s learns no moves in %s\n", pokemon.Name, versionGroup)
+		return nil
+	}
+
+	var names []string
+	for _, row := range rows {
+		if !slices.Contains(names, row.move.Name) {
+			names = append(names, row.move.Name)
+		}
+	}
+	moves := map[string]pokeclient.Move{}
+	for _, result := range pokeclient.Batch(ctx, c.client, names, c.client.GetMove) {
+		if result.Err != nil {
+			return result.Err
+		}
+		moves[result.Key] = result.Value
+	}
+	for i := range rows {
+		rows[i].move = moves[rows[i].move.Name]
+		rows[i].name = displayName(c, rows[i].move, rows[i].move.Name)
+	}
+	slices.SortStableFunc(rows, sortFunc)
+
+	fmt.Printf("Moves for %s in %s:\n", pokemon.Name, versionGroup)
+	printLearnset(os.Stdout, rows, language(c))
+	return nil
+}
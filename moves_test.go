@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func versionGroupDetail(level int, method, versionGroup string, id string) pokeclient.MoveVersionDetail {
+	return pokeclient.MoveVersionDetail{
+		LevelLearnedAt:  level,
+		MoveLearnMethod: pokeclient.Entry{Name: method},
+		VersionGroup:    pokeclient.Entry{Name: versionGroup, Url: "https://pokeapi.co/api/v2/version-group/" + id + "/"},
+	}
+}
+
+var pikachuMoves = []pokeclient.PokemonMove{
+	{
+		Move: pokeclient.Entry{Name: "thunder-shock"},
+		VersionGroupDetails: []pokeclient.MoveVersionDetail{
+			versionGroupDetail(1, "level-up", "red-blue", "1"),
+			versionGroupDetail(1, "level-up", "sword-shield", "20"),
+		},
+	},
+	{
+		Move: pokeclient.Entry{Name: "thunderbolt"},
+		VersionGroupDetails: []pokeclient.MoveVersionDetail{
+			versionGroupDetail(0, "machine", "red-blue", "1"),
+			versionGroupDetail(0, "machine", "sword-shield", "20"),
+		},
+	},
+	{
+		Move: pokeclient.Entry{Name: "thunder-wave"},
+		VersionGroupDetails: []pokeclient.MoveVersionDetail{
+			versionGroupDetail(9, "level-up", "red-blue", "1"),
+		},
+	},
+}
+
+func TestLatestVersionGroup(t *testing.T) {
+	if latest := latestVersionGroup(pikachuMoves); latest != "sword-shield" {
+		t.Errorf("Expected sword-shield, got %s", latest)
+	}
+	if latest := latestVersionGroup(nil); latest != "" {
+		t.Errorf("Expected no version group without moves, got %s", latest)
+	}
+}
+
+func TestFilterLearnset(t *testing.T) {
+	rows := filterLearnset(pikachuMoves, "red-blue", "")
+	if len(rows) != 3 {
+		t.Errorf("Expected 3 moves in red-blue, got %d", len(rows))
+	}
+
+	rows = filterLearnset(pikachuMoves, "red-blue", "level-up")
+	var names []string
+	for _, row := range rows {
+		names = append(names, row.move.Name)
+	}
+	if !slices.Equal(names, []string{"thunder-shock", "thunder-wave"}) {
+		t.Errorf("Expected level-up moves [thunder-shock thunder-wave], got %v", names)
+	}
+
+	if rows := filterLearnset(pikachuMoves, "gold-silver", ""); len(rows) != 0 {
+		t.Errorf("Expected no moves in gold-silver, got %d", len(rows))
+	}
+}
+
+func TestLearnsetSorts(t *testing.T) {
+	power := func(p int) *int { return &p }
+	rows := []learnsetRow{
+		{name: "thunderbolt", move: pokeclient.Move{Name: "thunderbolt", Power: power(90), Type: pokeclient.Entry{Name: "electric"}}, method: "machine"},
+		{name: "thunder-wave", move: pokeclient.Move{Name: "thunder-wave", Type: pokeclient.Entry{Name: "electric"}}, method: "level-up", level: 9},
+		{name: "quick-attack", move: pokeclient.Move{Name: "quick-attack", Power: power(40), Type: pokeclient.Entry{Name: "normal"}}, method: "level-up", level: 16},
+		{name: "thunder-shock", move: pokeclient.Move{Name: "thunder-shock", Power: power(40), Type: pokeclient.Entry{Name: "electric"}}, method: "level-up", level: 1},
+	}
+
+	cases := []struct {
+		sort     string
+		expected []string
+	}{
+		{sort: "level", expected: []string{"thunder-shock", "thunder-wave", "quick-attack", "thunderbolt"}},
+		{sort: "name", expected: []string{"quick-attack", "thunder-shock", "thunder-wave", "thunderbolt"}},
+		{sort: "power", expected: []string{"thunderbolt", "quick-attack", "thunder-shock", "thunder-wave"}},
+		{sort: "type", expected: []string{"thunder-shock", "thunder-wave", "thunderbolt", "quick-attack"}},
+	}
+	for _, c := range cases {
+		sorted := slices.Clone(rows)
+		slices.SortStableFunc(sorted, learnsetSorts[c.sort])
+		var names []string
+		for _, row := range sorted {
+			names = append(names, row.move.Name)
+		}
+		if !slices.Equal(names, c.expected) {
+			t.Errorf("Sorting by %s: expected %v, got %v", c.sort, c.expected, names)
+		}
+	}
+}
+
+func TestLearnsetSortsByShownName(t *testing.T) {
+	rows := []learnsetRow{
+		{name: "でんきショック", move: pokeclient.Move{Name: "thunder-shock"}},
+		{name: "10まんボルト", move: pokeclient.Move{Name: "thunderbolt"}},
+	}
+	slices.SortStableFunc(rows, learnsetSorts["name"])
+	if rows[0].move.Name != "thunderbolt" {
+		t.Errorf("Expected moves to be sorted by the name shown, got %v", rows)
+	}
+}
+
+func TestPrintLearnset(t *testing.T) {
+	power, accuracy, pp, chance := 40, 100, 30, 10
+	rows := []learnsetRow{
+		{
+			name: "thunder-shock",
+			move: pokeclient.Move{
+				Name: "thunder-shock", Type: pokeclient.Entry{Name: "electric"}, DamageClass: pokeclient.Entry{Name: "special"},
+				Power: &power, Accuracy: &accuracy, PP: &pp, EffectChance: &chance,
+				EffectEntries: []pokeclient.EffectEntry{
+					{ShortEffect: "Has a $effect_chance% chance to paralyze the target.", Language: pokeclient.Entry{Name: "en"}},
+				},
+			},
+			method: "level-up",
+			level:  1,
+		},
+		{
+			name:   "growl",
+			move:   pokeclient.Move{Name: "growl", Type: pokeclient.Entry{Name: "normal"}, DamageClass: pokeclient.Entry{Name: "status"}},
+			method: "egg",
+		},
+	}
+
+	var out strings.Builder
+	printLearnset(&out, rows, "ja")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected a header and 2 rows, got:\n%s", out.String())
+	}
+	if fields := strings.Fields(lines[1]); !slices.Equal(fields[:8], []string{"1", "thunder-shock", "level-up", "electric", "special", "40", "100", "30"}) {
+		t.Errorf("Unexpected level-up row %v", fields)
+	}
+	if !strings.HasSuffix(lines[1], "Has a 10% chance to paralyze the target.") {
+		t.Errorf("Expected the English effect when there is no Japanese one, got %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); !slices.Equal(fields, []string{"-", "growl", "egg", "normal", "status", "-", "-", "-"}) {
+		t.Errorf("Unexpected egg move row %v", fields)
+	}
+}
+
+func TestCommandMovesNoArgs(t *testing.T) {
+	config := &Config{
+		args: []string{"--version", "red-blue"},
+	}
+
+	err := commandMoves(context.Background(), config)
+	if err == nil {
+		t.Error("commandMoves should return error when no pokemon is provided")
+	}
+}
+
+func TestCommandMovesUnknownSort(t *testing.T) {
+	config := &Config{
+		args: []string{"pikachu", "--sort", "speed"},
+	}
+
+	err := commandMoves(context.Background(), config)
+	if err == nil {
+		t.Error("commandMoves should return error for an unknown sort")
+	}
+}
+
+func TestCommandMoves(t *testing.T) {
+	moveRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch {
+		case r.URL.Path == "/pokemon/pikachu":
+			w.Write([]byte(`{"name": "pikachu", "moves": [
+				{"move": {"name": "thunder-shock"}, "version_group_details": [
+					{"level_learned_at": 1, "move_learn_method": {"name": "level-up"}, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}}
+				]},
+				{"move": {"name": "thunderbolt"}, "version_group_details": [
+					{"level_learned_at": 0, "move_learn_method": {"name": "machine"}, "version_group": {"name": "red-blue", "url": "https://pokeapi.co/api/v2/version-group/1/"}}
+				]}
+			]}`))
+		case strings.HasPrefix(r.URL.Path, "/move/"):
+			moveRequests++
+			name := strings.TrimPrefix(r.URL.Path, "/move/")
+			w.Write([]byte(`{"name": "` + name + `", "type": {"name": "electric"}, "power": 40}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{"pikachu", "--method", "level-up"},
+		client: newTestClient(t, server.URL),
+	}
+
+	err := commandMoves(context.Background(), config)
+	if err != nil {
+		t.Errorf("commandMoves should not return error, got %v", err)
+	}
+	if moveRequests != 1 {
+		t.Errorf("Expected only the filtered move to be fetched, got %d move requests", moveRequests)
+	}
+}
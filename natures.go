@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func commandNature(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, a nature name. Received none")
+	}
+	nature, err := c.client.GetNature(ctx, c.args[0])
+	if errors.Is(err, pokeclient.ErrNatureNotFound) {
+		fmt.Printf("Nature %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Name: %s\n", nature.Name)
+	if nature.Neutral() {
+		fmt.Println("Neutral, no stat is changed")
+		return nil
+	}
+	fmt.Printf("  +10%% %s\n", nature.IncreasedStat.Name)
+	fmt.Printf("  -10%% %s\n", nature.DecreasedStat.Name)
+	if nature.LikesFlavor != nil {
+		fmt.Printf("Likes %s food\n", nature.LikesFlavor.Name)
+	}
+	if nature.HatesFlavor != nil {
+		fmt.Printf("Hates %s food\n", nature.HatesFlavor.Name)
+	}
+	return nil
+}
+
+func commandGrowth(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, a Pokemon name. Received none")
+	}
+	pokemon, err := lookupPokemon(ctx, c, c.args[0])
+	if errors.Is(err, pokeclient.ErrPokemonNotFound) {
+		fmt.Printf("Pokemon %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	species, err := c.client.GetPokemonSpecies(ctx, pokemon.Species.Name)
+	if err != nil {
+		return err
+	}
+	rate, err := c.client.GetGrowthRate(ctx, species.GrowthRate.Name)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Growth rate of %s: %s\n", pokemon.Name, rate.Name)
+	if description := localizedText(c, rate.Description); description != "" {
+		fmt.Println(description)
+	}
+	fmt.Printf("Formula: %s\n", rate.Formula)
+	printGrowthTable(os.Stdout, rate.Levels)
+	return nil
+}
+
+// printGrowthTable prints the total experience needed for every level and
+// how much more is needed to reach the next one.
+func printGrowthTable(w io.Writer, levels []pokeclient.GrowthRateLevel) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "LEVEL\tTOTAL XP\tTO NEXT\t")
+	for i, level := range levels {
+		next := "-"
+		if i+1 < len(levels) {
+			next = fmt.Sprint(levels[i+1].Experience - level.Experience)
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t\n", level.Level, level.Experience, next)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func TestPrintGrowthTable(t *testing.T) {
+	var out strings.Builder
+	printGrowthTable(&out, []pokeclient.GrowthRateLevel{
+		{Level: 1, Experience: 0},
+		{Level: 2, Experience: 8},
+		{Level: 3, Experience: 27},
+	})
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected a header and 3 rows, got:\n%s", out.String())
+	}
+	expected := [][]string{{"1", "0", "8"}, {"2", "8", "19"}, {"3", "27", "-"}}
+	for i, row := range expected {
+		if fields := strings.Fields(lines[i+1]); !slices.Equal(fields, row) {
+			t.Errorf("Row %d: expected %v, got %v", i, row, fields)
+		}
+	}
+}
+
+func TestCommandNature(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name:           "known nature",
+			args:           []string{"adamant"},
+			serverResponse: `{"name": "adamant", "increased_stat": {"name": "attack"}, "decreased_stat": {"name": "special-attack"}}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			// Should not return error for unknown natures, just print message
+			name:         "unknown nature - 404",
+			args:         []string{"grumpy"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"adamant"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"adamant"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandNature(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCommandGrowth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "pikachu", "species": {"name": "pikachu"}}`))
+		case "/pokemon-species/pikachu":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "pikachu", "growth_rate": {"name": "medium"}}`))
+		case "/growth-rate/medium":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "medium", "formula": "x^3", "levels": [{"level": 1, "experience": 0}, {"level": 2, "experience": 8}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{"pikachu"},
+		client: newTestClient(t, server.URL),
+	}
+	if err := commandGrowth(context.Background(), config); err != nil {
+		t.Errorf("commandGrowth should not return error, got %v", err)
+	}
+	config.args = []string{}
+	if err := commandGrowth(context.Background(), config); err == nil {
+		t.Error("commandGrowth should return error when no arguments provided")
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+// regionPageSize matches the PokeAPI default page size used by map.
+const regionPageSize = 20
+
+// regionAreas pages through the location areas of one region, which PokeAPI
+// does not paginate.
+type regionAreas struct {
+	region string
+	areas  []string
+	// next is the index of the first area of the next page.
+	next int
+}
+
+func commandRegions(ctx context.Context, c *Config) error {
+	for region, err := range c.client.AllRegions(ctx, pokeclient.ListOptions{}) {
+		if err != nil {
+			return err
+		}
+		fmt.Println(region.Name)
+	}
+	return nil
+}
+
+func commandLocations(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, a region name. Received none")
+	}
+	region, err := c.client.GetRegion(ctx, c.args[0])
+	if errors.Is(err, pokeclient.ErrRegionNotFound) {
+		fmt.Printf("Region %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, location := range region.Locations {
+		fmt.Println(location.Name)
+	}
+	return nil
+}
+
+// loadRegionAreas makes region the one paged by map --region, starting from
+// its first page. It reports false when the region does not exist.
+func loadRegionAreas(ctx context.Context, c *Config, region string) (bool, error) {
+	if region == "" {
+		return false, errors.New("Expected a region after --region")
+	}
+	if c.regionAreas != nil && c.regionAreas.region == region {
+		return true, nil
+	}
+	areas, err := c.client.GetRegionLocationAreas(ctx, region)
+	if errors.Is(err, pokeclient.ErrRegionNotFound) {
+		fmt.Printf("Region %s does not exist\n", region)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	names := make([]string, len(areas))
+	for i, area := range areas {
+		names[i] = area.Name
+	}
+	c.regionAreas = &regionAreas{region: region, areas: names}
+	return true, nil
+}
+
+func commandMapRegion(ctx context.Context, c *Config, region string) error {
+	ok, err := loadRegionAreas(ctx, c, region)
+	if !ok || err != nil {
+		return err
+	}
+	pages := c.regionAreas
+	// Like map, paging past the last page starts over.
+	if pages.next >= len(pages.areas) {
+		pages.next = 0
+	}
+	printRegionPage(pages, pages.next)
+	return nil
+}
+
+func commandMapbRegion(ctx context.Context, c *Config, region string) error {
+	ok, err := loadRegionAreas(ctx, c, region)
+	if !ok || err != nil {
+		return err
+	}
+	pages := c.regionAreas
+	start := pages.next - 2*regionPageSize
+	if start < 0 {
+		fmt.Println("you're on the first page")
+		return nil
+	}
+	printRegionPage(pages, start)
+	return nil
+}
+
+func printRegionPage(pages *regionAreas, start int) {
+	end := min(start+regionPageSize, len(pages.areas))
+	for _, area := range pages.areas[start:end] {
+		fmt.Println(area)
+	}
+	pages.next = start + regionPageSize
+}
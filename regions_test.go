@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCommandRegions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"count": 1, "next": null, "previous": null, "results": [{"name": "kanto"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{},
+		client: newTestClient(t, server.URL),
+	}
+	if err := commandRegions(context.Background(), config); err != nil {
+		t.Errorf("commandRegions should not return error, got %v", err)
+	}
+}
+
+func TestCommandLocations(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		serverResponse string
+		serverStatus   int
+		expectError    bool
+	}{
+		{
+			name:           "known region",
+			args:           []string{"kanto"},
+			serverResponse: `{"name": "kanto", "locations": [{"name": "route-1"}]}`,
+			serverStatus:   http.StatusOK,
+		},
+		{
+			// Should not return error for unknown regions, just print message
+			name:         "unknown region - 404",
+			args:         []string{"orre"},
+			serverStatus: http.StatusNotFound,
+		},
+		{
+			name:         "server error",
+			args:         []string{"kanto"},
+			serverStatus: http.StatusInternalServerError,
+			expectError:  true,
+		},
+		{
+			name:           "malformed json",
+			args:           []string{"kanto"},
+			serverResponse: `{invalid json}`,
+			serverStatus:   http.StatusOK,
+			expectError:    true,
+		},
+		{
+			name:        "no arguments",
+			args:        []string{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverResponse))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandLocations(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCommandMapRegion(t *testing.T) {
+	// kanto has a single location with 45 areas, area-0 to area-44.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/region/kanto":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "kanto", "locations": [{"name": "route-1"}]}`))
+		case "/location/route-1":
+			results := make([]string, 45)
+			for i := range results {
+				results[i] = fmt.Sprintf(`{"name": "area-%d"}`, i)
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"name": "route-1", "areas": [%s]}`, strings.Join(results, ","))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{"--region", "kanto"},
+		client: newTestClient(t, server.URL),
+	}
+
+	if err := commandMapb(context.Background(), config); err != nil {
+		t.Fatalf("commandMapb should not return error, got %v", err)
+	}
+	if config.regionAreas.next != 0 {
+		t.Errorf("Expected mapb on the first page not to move, got next %d", config.regionAreas.next)
+	}
+
+	for _, expected := range []int{20, 40, 60} {
+		if err := commandMap(context.Background(), config); err != nil {
+			t.Fatalf("commandMap should not return error, got %v", err)
+		}
+		if config.regionAreas.next != expected {
+			t.Errorf("Expected next page to start at %d, got %d", expected, config.regionAreas.next)
+		}
+	}
+	if err := commandMapb(context.Background(), config); err != nil {
+		t.Fatalf("commandMapb should not return error, got %v", err)
+	}
+	if config.regionAreas.next != 40 {
+		t.Errorf("Expected mapb to show the second page, got next %d", config.regionAreas.next)
+	}
+	config.regionAreas.next = 60
+	if err := commandMap(context.Background(), config); err != nil {
+		t.Fatalf("commandMap should not return error, got %v", err)
+	}
+	if config.regionAreas.next != 20 {
+		t.Errorf("Expected map to start over after the last page, got next %d", config.regionAreas.next)
+	}
+	if config.Next != nil || config.Prev != nil {
+		t.Error("Paging a region should not move the location area pages")
+	}
+
+	// Should not return error for unknown regions, just print message
+	config.args = []string{"--region", "orre"}
+	if err := commandMap(context.Background(), config); err != nil {
+		t.Errorf("commandMap should not return error for unknown region, got %v", err)
+	}
+}
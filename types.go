@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+// weaknessBuckets are the multipliers a pokemon can take from an attack,
+// strongest first.
+var weaknessBuckets = []float64{4, 2, 1, 0.5, 0.25, 0}
+
+func commandType(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, a type name. Received none")
+	}
+	typeDetails, err := c.client.GetType(ctx, c.args[0])
+	if errors.Is(err, pokeclient.ErrTypeNotFound) {
+		fmt.Printf("Type %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	relations := typeDetails.DamageRelations
+	fmt.Printf("Type: %s\n", typeDetails.Name)
+	fmt.Println("Attacking:")
+	printTypeRelation(os.Stdout, "2x against", relations.DoubleDamageTo)
+	printTypeRelation(os.Stdout, "0.5x against", relations.HalfDamageTo)
+	printTypeRelation(os.Stdout, "no effect on", relations.NoDamageTo)
+	fmt.Println("Defending:")
+	printTypeRelation(os.Stdout, "2x from", relations.DoubleDamageFrom)
+	printTypeRelation(os.Stdout, "0.5x from", relations.HalfDamageFrom)
+	printTypeRelation(os.Stdout, "immune to", relations.NoDamageFrom)
+	return nil
+}
+
+func printTypeRelation(w io.Writer, label string, types []pokeclient.Entry) {
+	if len(types) == 0 {
+		return
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.Name
+	}
+	fmt.Fprintf(w, "  %s: %s\n", label, strings.Join(names, ", "))
+}
+
+func commandWeakness(ctx context.Context, c *Config) error {
+	if len(c.args) < 1 {
+		return errors.New("Expected one argument, a Pokemon name. Received none")
+	}
+	pokemon, err := lookupPokemon(ctx, c, c.args[0])
+	if errors.Is(err, pokeclient.ErrPokemonNotFound) {
+		fmt.Printf("Pokemon %s does not exist\n", c.args[0])
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	chart, err := c.client.GetTypeChart(ctx)
+	if err != nil {
+		return err
+	}
+	types := make([]string, len(pokemon.Types))
+	for i, t := range pokemon.Types {
+		types[i] = t.Type.Name
+	}
+	fmt.Printf("%s (%s)\n", pokemon.Name, strings.Join(types, "/"))
+	printWeaknesses(os.Stdout, chart.Defending(types...))
+	return nil
+}
+
+// printWeaknesses groups attacking types by the damage they deal, skipping
+// empty groups.
+func printWeaknesses(w io.Writer, multipliers map[string]float64) {
+	for _, bucket := range weaknessBuckets {
+		var types []string
+		for attacking, multiplier := range multipliers {
+			if multiplier == bucket {
+				types = append(types, attacking)
+			}
+		}
+		if len(types) == 0 {
+			continue
+		}
+		slices.Sort(types)
+		fmt.Fprintf(w, "  %gx: %s\n", bucket, strings.Join(types, ", "))
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPrintWeaknesses(t *testing.T) {
+	var out strings.Builder
+	printWeaknesses(&out, map[string]float64{
+		"grass":    4,
+		"water":    2,
+		"ice":      2,
+		"normal":   1,
+		"fire":     0.5,
+		"electric": 0,
+	})
+
+	expected := "  4x: grass\n  2x: ice, water\n  1x: normal\n  0.5x: fire\n  0x: electric\n"
+	if out.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestCommandTypeNoArgs(t *testing.T) {
+	config := &Config{
+		args: []string{},
+	}
+
+	err := commandType(context.Background(), config)
+	if err == nil {
+		t.Error("commandType should return error when no arguments provided")
+	}
+}
+
+func TestCommandWeakness(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "pikachu", "types": [{"type": {"name": "electric"}}]}`))
+		case "/type":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count": 2, "next": null, "results": [{"name": "electric"}, {"name": "ground"}]}`))
+		case "/type/electric":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "electric", "damage_relations": {"no_damage_to": [{"name": "ground"}]}}`))
+		case "/type/ground":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "ground", "damage_relations": {"double_damage_to": [{"name": "electric"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		args:   []string{"pikachu"},
+		client: newTestClient(t, server.URL),
+	}
+	if err := commandWeakness(context.Background(), config); err != nil {
+		t.Errorf("commandWeakness should not return error, got %v", err)
+	}
+
+	// Should not return error for unknown pokemon, just print message
+	config.args = []string{"missingno"}
+	if err := commandWeakness(context.Background(), config); err != nil {
+		t.Errorf("commandWeakness should not return error for unknown pokemon, got %v", err)
+	}
+}
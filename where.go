@@ -0,0 +1,124 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+	"github.com/jabreu610/pokedexcli/internal/repl"
+)
+
+// whereRow is one way of encountering a pokemon in a location area in one
+// game.
+type whereRow struct {
+	region  string
+	version pokeclient.Entry
+	area    string
+	summary pokeclient.EncounterSummary
+}
+
+func commandWhere(ctx context.Context, c *Config) error {
+	args := repl.ParseArgs(c.args)
+	if len(args.Positional) < 1 {
+		return errors.New("Expected one argument, a Pokemon name. Received none")
+	}
+	name := args.Positional[0]
+	pokemon, err := lookupPokemon(ctx, c, name)
+	if errors.Is(err, pokeclient.ErrPokemonNotFound) {
+		fmt.Printf("Pokemon %s does not exist\n", name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	encounters, err := c.client.GetPokemonEncounters(ctx, pokemon.Name)
+	if err != nil {
+		return err
+	}
+
+	version := versionFlag(args, c)
+	regions := map[string]string{}
+	for _, result := range pokeclient.Batch(ctx, c.client, encounterAreas(encounters, version), c.client.GetLocationAreaRegion) {
+		if result.Err != nil {
+			return result.Err
+		}
+		regions[result.Key] = result.Value
+	}
+
+	rows := whereRows(encounters, regions, version)
+	if len(rows) == 0 {
+		fmt.Printf("%s cannot be found in the wild\n", pokemon.Name)
+		return nil
+	}
+	printWhere(os.Stdout, rows)
+	return nil
+}
+
+// encounterAreas returns the areas with encounters in version, or in any game
+// when version is empty. Looking up the region of an area takes two requests,
+// so only these areas are looked up.
+func encounterAreas(encounters []pokeclient.LocationAreaEncounter, version string) []string {
+	var areas []string
+	for _, encounter := range encounters {
+		if slices.ContainsFunc(encounter.VersionDetails, func(details pokeclient.VersionEncounterDetail) bool {
+			return version == "" || details.Version.Name == version
+		}) {
+			areas = append(areas, encounter.LocationArea.Name)
+		}
+	}
+	return areas
+}
+
+// whereRows flattens encounters into rows sorted by region, then by game in
+// release order, keeping the order of areas within a game. An empty version
+// keeps every game.
+func whereRows(encounters []pokeclient.LocationAreaEncounter, regions map[string]string, version string) []whereRow {
+	var rows []whereRow
+	for _, encounter := range encounters {
+		area := encounter.LocationArea.Name
+		region := regions[area]
+		if region == "" {
+			region = "unknown region"
+		}
+		for _, details := range encounter.VersionDetails {
+			if version != "" && details.Version.Name != version {
+				continue
+			}
+			for _, summary := range pokeclient.SummarizeEncounters(details.EncounterDetails) {
+				rows = append(rows, whereRow{region: region, version: details.Version, area: area, summary: summary})
+			}
+		}
+	}
+	slices.SortStableFunc(rows, func(a, b whereRow) int {
+		return cmp.Or(
+			cmp.Compare(a.region, b.region),
+			cmp.Compare(a.version.ID(), b.version.ID()),
+			cmp.Compare(a.version.Name, b.version.Name),
+		)
+	})
+	return rows
+}
+
+// printWhere prints a heading for every region and game, followed by the
+// areas of that game.
+func printWhere(w io.Writer, rows []whereRow) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, row := range rows {
+		if i == 0 || row.region != rows[i-1].region {
+			fmt.Fprintf(tw, "%s\n", row.region)
+		}
+		if i == 0 || row.region != rows[i-1].region || row.version.Name != rows[i-1].version.Name {
+			fmt.Fprintf(tw, "  %s\n", row.version.Name)
+		}
+		fmt.Fprintf(tw, "    %s\t%s\t%s\t%d%%\t%s\n",
+			row.area, row.summary.Method, formatLevels(row.summary.MinLevel, row.summary.MaxLevel),
+			row.summary.Chance, formatConditions(row.summary.Conditions))
+	}
+	tw.Flush()
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jabreu610/pokedexcli/internal/pokeclient"
+)
+
+func version(name, id string) pokeclient.Entry {
+	return pokeclient.Entry{Name: name, Url: "https://pokeapi.co/api/v2/version/" + id + "/"}
+}
+
+var pikachuEncounters = []pokeclient.LocationAreaEncounter{
+	{
+		LocationArea: pokeclient.Entry{Name: "viridian-forest-area"},
+		VersionDetails: []pokeclient.VersionEncounterDetail{
+			{Version: version("yellow", "3"), EncounterDetails: []pokeclient.Encounter{{MinLevel: 3, MaxLevel: 5, Chance: 10, Method: pokeclient.Entry{Name: "walk"}}}},
+			{Version: version("red", "1"), EncounterDetails: []pokeclient.Encounter{{MinLevel: 3, MaxLevel: 3, Chance: 5, Method: pokeclient.Entry{Name: "walk"}}}},
+		},
+	},
+	{
+		LocationArea: pokeclient.Entry{Name: "trophy-garden-area"},
+		VersionDetails: []pokeclient.VersionEncounterDetail{
+			{Version: version("diamond", "12"), EncounterDetails: []pokeclient.Encounter{{MinLevel: 16, MaxLevel: 16, Chance: 10, Method: pokeclient.Entry{Name: "walk"}}}},
+		},
+	},
+}
+
+var pikachuRegions = map[string]string{
+	"viridian-forest-area": "kanto",
+	"trophy-garden-area":   "sinnoh",
+}
+
+func TestWhereRows(t *testing.T) {
+	rows := whereRows(pikachuEncounters, pikachuRegions, "")
+
+	var got []string
+	for _, row := range rows {
+		got = append(got, row.region+"/"+row.version.Name+"/"+row.area)
+	}
+	expected := "kanto/red/viridian-forest-area kanto/yellow/viridian-forest-area sinnoh/diamond/trophy-garden-area"
+	if strings.Join(got, " ") != expected {
+		t.Errorf("Expected %s, got %v", expected, got)
+	}
+
+	rows = whereRows(pikachuEncounters, pikachuRegions, "yellow")
+	if len(rows) != 1 || rows[0].summary.Chance != 10 {
+		t.Errorf("Expected a single yellow row, got %+v", rows)
+	}
+}
+
+func TestEncounterAreas(t *testing.T) {
+	if areas := encounterAreas(pikachuEncounters, ""); !slices.Equal(areas, []string{"viridian-forest-area", "trophy-garden-area"}) {
+		t.Errorf("Expected every area, got %v", areas)
+	}
+	if areas := encounterAreas(pikachuEncounters, "diamond"); !slices.Equal(areas, []string{"trophy-garden-area"}) {
+		t.Errorf("Expected only the diamond area, got %v", areas)
+	}
+	if areas := encounterAreas(pikachuEncounters, "gold"); len(areas) != 0 {
+		t.Errorf("Expected no areas in gold, got %v", areas)
+	}
+}
+
+func TestPrintWhere(t *testing.T) {
+	var out strings.Builder
+	printWhere(&out, whereRows(pikachuEncounters, pikachuRegions, ""))
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	expected := []string{"kanto", "  red", "    viridian-forest-area  walk  3", "  yellow", "    viridian-forest-area  walk  3-5", "sinnoh", "  diamond", "    trophy-garden-area  walk  16"}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got:\n%s", len(expected), out.String())
+	}
+	for i, line := range lines {
+		if !strings.HasPrefix(line, expected[i]) {
+			t.Errorf("Line %d: expected prefix %q, got %q", i, expected[i], line)
+		}
+	}
+}
+
+// whereResponses serves pikachu in viridian-forest-area in red, and in
+// trophy-garden-area in diamond. The region of trophy-garden-area cannot be
+// looked up, so where fails unless the version filter skips it.
+var whereResponses = map[string]string{
+	"/pokemon/pikachu": `{"name": "pikachu"}`,
+	"/pokemon/pikachu/encounters": `[{"location_area": {"name": "viridian-forest-area"}, "version_details": [
+		{"version": {"name": "red"}, "encounter_details": [{"min_level": 3, "max_level": 3, "chance": 5, "method": {"name": "walk"}}]}
+	]}, {"location_area": {"name": "trophy-garden-area"}, "version_details": [
+		{"version": {"name": "diamond"}, "encounter_details": [{"min_level": 16, "max_level": 16, "chance": 10, "method": {"name": "walk"}}]}
+	]}]`,
+	"/pokemon/mew":                        `{"name": "mew"}`,
+	"/pokemon/mew/encounters":             `[]`,
+	"/location-area/viridian-forest-area": `{"name": "viridian-forest-area", "location": {"name": "viridian-forest"}}`,
+	"/location/viridian-forest":           `{"name": "viridian-forest", "region": {"name": "kanto"}}`,
+}
+
+func TestCommandWhere(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		serverResponses map[string]string
+		serverStatus    int
+		expectError     bool
+	}{
+		{
+			name:            "areas in the chosen version",
+			args:            []string{"pikachu", "--version", "red"},
+			serverResponses: whereResponses,
+			serverStatus:    http.StatusOK,
+		},
+		{
+			name:            "area without a region",
+			args:            []string{"pikachu"},
+			serverResponses: whereResponses,
+			serverStatus:    http.StatusOK,
+			expectError:     true,
+		},
+		{
+			name:            "pokemon not found in the wild",
+			args:            []string{"mew"},
+			serverResponses: whereResponses,
+			serverStatus:    http.StatusOK,
+		},
+		{
+			// Should not return error for unknown pokemon, just print message
+			name:            "unknown pokemon - 404",
+			args:            []string{"missingno"},
+			serverResponses: whereResponses,
+			serverStatus:    http.StatusOK,
+		},
+		{
+			name:            "server error",
+			args:            []string{"pikachu"},
+			serverResponses: whereResponses,
+			serverStatus:    http.StatusInternalServerError,
+			expectError:     true,
+		},
+		{
+			name: "malformed json",
+			args: []string{"pikachu"},
+			serverResponses: map[string]string{
+				"/pokemon/pikachu":            `{"name": "pikachu"}`,
+				"/pokemon/pikachu/encounters": `[invalid json]`,
+			},
+			serverStatus: http.StatusOK,
+			expectError:  true,
+		},
+		{
+			name:        "no arguments",
+			args:        []string{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				response, ok := tt.serverResponses[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				args:   tt.args,
+				client: newTestClient(t, server.URL),
+			}
+			err := commandWhere(context.Background(), config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}